@@ -16,4 +16,10 @@ func IntegrationController(router fiber.Router, configClients configs.ConfigClie
 	integrationController.Post("/trapezoid", integrationValidate.ValidateTrapezoid, integrationService.CreateTrapezoid)
 	integrationController.Get("/simpson/:id", integrationService.GetSimpson)
 	integrationController.Post("/simpson", integrationValidate.ValidateSimpson, integrationService.CreateSimpson)
+	integrationController.Get("/multiple/:id", integrationService.GetMultipleIntegral)
+	integrationController.Post("/multiple", integrationValidate.ValidateMultipleIntegral, integrationService.CreateMultipleIntegral)
+	integrationController.Get("/improper/:id", integrationService.GetImproperIntegral)
+	integrationController.Post("/improper", integrationValidate.ValidateImproperIntegral, integrationService.CreateImproperIntegral)
+	integrationController.Get("/monte-carlo/:id", integrationService.GetMonteCarlo)
+	integrationController.Post("/monte-carlo", integrationValidate.ValidateMonteCarlo, integrationService.CreateMonteCarlo)
 }
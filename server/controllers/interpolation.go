@@ -22,4 +22,16 @@ func InterpolationController(router fiber.Router, configClients configs.ConfigCl
 	interpolationController.Post("/quadratic-lagrange", interpolationValidate.ValidateQuadraticLagrange, interpolationService.CreateQuadraticLagrange)
 	interpolationController.Get("/quadratic-spline/:id", interpolationService.GetQuadraticSpline)
 	interpolationController.Post("/quadratic-spline", interpolationValidate.ValidateQuadraticSpline, interpolationService.CreateQuadraticSpline)
+	interpolationController.Get("/chebyshev/:id", interpolationService.GetChebyshev)
+	interpolationController.Post("/chebyshev", interpolationValidate.ValidateChebyshev, interpolationService.CreateChebyshev)
+	interpolationController.Get("/pade/:id", interpolationService.GetPade)
+	interpolationController.Post("/pade", interpolationValidate.ValidatePade, interpolationService.CreatePade)
+	interpolationController.Get("/floater-hormann/:id", interpolationService.GetFloaterHormann)
+	interpolationController.Post("/floater-hormann", interpolationValidate.ValidateFloaterHormann, interpolationService.CreateFloaterHormann)
+	interpolationController.Get("/bivariate/:id", interpolationService.GetBivariate)
+	interpolationController.Post("/bivariate", interpolationValidate.ValidateBivariate, interpolationService.CreateBivariate)
+	interpolationController.Get("/hermite/:id", interpolationService.GetHermite)
+	interpolationController.Post("/hermite", interpolationValidate.ValidateHermite, interpolationService.CreateHermite)
+	interpolationController.Get("/fft/:id", interpolationService.GetFFT)
+	interpolationController.Post("/fft", interpolationValidate.ValidateFFT, interpolationService.CreateFFT)
 }
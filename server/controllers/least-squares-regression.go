@@ -18,4 +18,10 @@ func LeastSquaresRegressionController(router fiber.Router, configClients configs
 	leastSquaresRegressionController.Post("/polynomial-regression", leastSquaresRegressionValidate.ValidatePolynomialRegression, leastSquaresRegressionService.CreatePolynomialRegression)
 	leastSquaresRegressionController.Get("/multiple-regression/:id", leastSquaresRegressionService.GetMultipleRegression)
 	leastSquaresRegressionController.Post("/multiple-regression", leastSquaresRegressionValidate.ValidateMultipleRegression, leastSquaresRegressionService.CreateMultipleRegression)
+	leastSquaresRegressionController.Get("/nonlinear-regression/:id", leastSquaresRegressionService.GetNonlinearRegression)
+	leastSquaresRegressionController.Post("/nonlinear-regression", leastSquaresRegressionValidate.ValidateNonlinearRegression, leastSquaresRegressionService.CreateNonlinearRegression)
+	leastSquaresRegressionController.Get("/b-spline-regression/:id", leastSquaresRegressionService.GetBSplineRegression)
+	leastSquaresRegressionController.Post("/b-spline-regression", leastSquaresRegressionValidate.ValidateBSplineRegression, leastSquaresRegressionService.CreateBSplineRegression)
+	leastSquaresRegressionController.Get("/smoothing-spline/:id", leastSquaresRegressionService.GetSmoothingSpline)
+	leastSquaresRegressionController.Post("/smoothing-spline", leastSquaresRegressionValidate.ValidateSmoothingSpline, leastSquaresRegressionService.CreateSmoothingSpline)
 }
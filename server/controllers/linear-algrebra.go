@@ -13,8 +13,18 @@ func LinearAlgrebraController(router fiber.Router, configClients configs.ConfigC
 	linearValidate := validations.NewLinearValidate()
 
 	linearController.Get("/matrix/:id", linearService.GetMatrix)
+	linearController.Get("/matrix/:id/analysis", linearService.GetMatrixAnalysis)
+	linearController.Get("/matrix/:id/qr", linearValidate.ValidateQR, linearService.GetQR)
 	linearController.Post("/matrix", linearValidate.ValidateMatrix, linearService.CreateMatrix)
 	linearController.Get("/matrix-iteration/:id", linearService.GetMatrixIteration)
 	linearController.Post("/matrix-iteration", linearValidate.ValidateMatrixIteration, linearService.CreateMatrixIteration)
+	linearController.Get("/matrix-iteration/:id/pcg", linearValidate.ValidatePCG, linearService.GetPCG)
+	linearController.Get("/matrix-iteration/:id/sor", linearValidate.ValidateSOR, linearService.GetSOR)
+	linearController.Get("/gmres/:id", linearService.GetGMRES)
+	linearController.Post("/gmres", linearValidate.ValidateGMRES, linearService.CreateGMRES)
+	linearController.Post("/gmres/upload", linearValidate.ValidateGMRESUpload, linearService.UploadGMRES)
+	linearController.Get("/bicgstab/:id", linearService.GetBiCGSTAB)
+	linearController.Post("/bicgstab", linearValidate.ValidateBiCGSTAB, linearService.CreateBiCGSTAB)
+	linearController.Post("/bicgstab/upload", linearValidate.ValidateBiCGSTABUpload, linearService.UploadBiCGSTAB)
 
 }
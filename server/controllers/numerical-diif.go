@@ -14,4 +14,6 @@ func NumericalDiffController(router fiber.Router, configClients configs.ConfigCl
 
 	numericalDiffController.Get("/:id", numericalDiffService.GetNumericalDiff)
 	numericalDiffController.Post("/", numericalDiffValidate.ValidateNumericalDiff, numericalDiffService.CreateNumericalDiff)
+	numericalDiffController.Get("/symbolic/:id", numericalDiffService.GetSymbolicDiff)
+	numericalDiffController.Post("/symbolic", numericalDiffValidate.ValidateSymbolicDiff, numericalDiffService.CreateSymbolicDiff)
 }
@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/BaimhonS/numerical-method/configs"
+	"github.com/BaimhonS/numerical-method/services"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+)
+
+func ODEController(router fiber.Router, configClients configs.ConfigClients) {
+	odeController := router.Group("/ode")
+	odeService := services.NewODEService(configClients.DB)
+	odeValidate := validations.NewODEValidate()
+
+	odeController.Get("/bvp/:id", odeService.GetBVP)
+	odeController.Post("/bvp", odeValidate.ValidateBVP, odeService.CreateBVP)
+}
@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"github.com/BaimhonS/numerical-method/configs"
+	"github.com/BaimhonS/numerical-method/services"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+)
+
+func OptimizationController(router fiber.Router, configClients configs.ConfigClients) {
+	optimizationController := router.Group("/optimization")
+	optimizationService := services.NewOptimizationService(configClients.DB)
+	optimizationValidate := validations.NewOptimizationValidate()
+
+	optimizationController.Get("/golden-section/:id", optimizationService.GetGoldenSection)
+	optimizationController.Post("/golden-section", optimizationValidate.ValidateGoldenSection, optimizationService.CreateGoldenSection)
+	optimizationController.Get("/parabolic-interpolation/:id", optimizationService.GetParabolicInterpolation)
+	optimizationController.Post("/parabolic-interpolation", optimizationValidate.ValidateParabolicInterpolation, optimizationService.CreateParabolicInterpolation)
+	optimizationController.Get("/gradient-descent/:id", optimizationService.GetGradientDescent)
+	optimizationController.Post("/gradient-descent", optimizationValidate.ValidateGradientDescent, optimizationService.CreateGradientDescent)
+	optimizationController.Get("/nelder-mead/:id", optimizationService.GetNelderMead)
+	optimizationController.Post("/nelder-mead", optimizationValidate.ValidateNelderMead, optimizationService.CreateNelderMead)
+	optimizationController.Get("/bfgs/:id", optimizationService.GetBFGS)
+	optimizationController.Post("/bfgs", optimizationValidate.ValidateBFGS, optimizationService.CreateBFGS)
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/BaimhonS/numerical-method/configs"
+	"github.com/BaimhonS/numerical-method/services"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+)
+
+func PDEController(router fiber.Router, configClients configs.ConfigClients) {
+	pdeController := router.Group("/pde")
+	pdeService := services.NewPDEService(configClients.DB)
+	pdeValidate := validations.NewPDEValidate()
+
+	pdeController.Get("/heat/:id", pdeService.GetHeatEquation)
+	pdeController.Post("/heat", pdeValidate.ValidateHeatEquation, pdeService.CreateHeatEquation)
+	pdeController.Get("/wave/:id", pdeService.GetWaveEquation)
+	pdeController.Post("/wave", pdeValidate.ValidateWaveEquation, pdeService.CreateWaveEquation)
+	pdeController.Get("/poisson/:id", pdeService.GetPoisson)
+	pdeController.Post("/poisson", pdeValidate.ValidatePoisson, pdeService.CreatePoisson)
+}
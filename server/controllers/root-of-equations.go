@@ -24,4 +24,14 @@ func RootOfEquationController(router fiber.Router, configClients configs.ConfigC
 	rootController.Get("/newton-raphson/:id", rootService.GetNewtonRaphson)
 	rootController.Post("/secant", rootValidate.ValidateSecant, rootService.CreateSecant)
 	rootController.Get("/secant/:id", rootService.GetSecant)
+	rootController.Post("/polynomial", rootValidate.ValidatePolynomialRoots, rootService.CreatePolynomialRoots)
+	rootController.Get("/polynomial/:id", rootService.GetPolynomialRoots)
+	rootController.Post("/brent", rootValidate.ValidateBisection, rootService.CreateBrent)
+	rootController.Get("/brent/:id", rootService.GetBrent)
+	rootController.Post("/ridders", rootValidate.ValidateBisection, rootService.CreateRidders)
+	rootController.Get("/ridders/:id", rootService.GetRidders)
+	rootController.Post("/itp", rootValidate.ValidateBisection, rootService.CreateITP)
+	rootController.Get("/itp/:id", rootService.GetITP)
+	rootController.Post("/interval-newton", rootValidate.ValidateBisection, rootService.CreateIntervalNewton)
+	rootController.Get("/interval-newton/:id", rootService.GetIntervalNewton)
 }
@@ -16,4 +16,7 @@ func SetUpController(app *fiber.App, configClients configs.ConfigClients) {
 	IntegrationController(controller, configClients)
 	InterpolationController(controller, configClients)
 	NumericalDiffController(controller, configClients)
+	OptimizationController(controller, configClients)
+	PDEController(controller, configClients)
+	ODEController(controller, configClients)
 }
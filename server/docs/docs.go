@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/numerical-method/integration/simpson": {
+        "/numerical-method/integration/improper": {
             "post": {
-                "description": "Create the simpson data",
+                "description": "Integrate over a finite or infinite interval (bounds may be -inf or inf) with tanh-sinh quadrature, a variable transformation with Gauss-Legendre, or Gauss-Laguerre/Hermite, refining until two levels agree to e percent",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,9 +25,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Simpson"
+                    "Improper Integral"
                 ],
-                "summary": "Create Simpson",
+                "summary": "Create Improper Integral Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -35,15 +35,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqSimpson"
+                            "$ref": "#/definitions/validations.ReqImproperIntegral"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Simpson"
+                            "$ref": "#/definitions/models.ImproperIntegral"
                         }
                     },
                     "400": {
@@ -55,9 +55,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/integration/simpson/{id}": {
+        "/numerical-method/integration/improper/{id}": {
             "get": {
-                "description": "Get the simpson data",
+                "description": "Get the improper integral result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -65,13 +65,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Simpson"
+                    "Improper Integral"
                 ],
-                "summary": "Get Simpson",
+                "summary": "Get Improper Integral Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Simpson ID",
+                        "description": "Improper Integral ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -81,7 +81,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Simpson"
+                            "$ref": "#/definitions/models.ImproperIntegral"
                         }
                     },
                     "400": {
@@ -89,13 +89,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/numerical-method/integration/trapezoid": {
+        "/numerical-method/integration/monte-carlo": {
             "post": {
-                "description": "Create the trapezoid data",
+                "description": "Estimate an integral over a box in one or more dimensions by seeded Monte Carlo, optionally antithetic or stratified, or by randomly shifted Sobol or Halton sequences, with its standard error and convergence series",
                 "consumes": [
                     "application/json"
                 ],
@@ -103,9 +109,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trapezoid"
+                    "Monte Carlo Integration"
                 ],
-                "summary": "Create Trapezoid",
+                "summary": "Create Monte Carlo Integration Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -113,15 +119,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqTrapezoid"
+                            "$ref": "#/definitions/validations.ReqMonteCarlo"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Trapezoid"
+                            "$ref": "#/definitions/models.MonteCarlo"
                         }
                     },
                     "400": {
@@ -133,9 +139,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/integration/trapezoid/{id}": {
+        "/numerical-method/integration/monte-carlo/{id}": {
             "get": {
-                "description": "Get the trapezoid data",
+                "description": "Get the Monte Carlo integration result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -143,13 +149,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trapezoid"
+                    "Monte Carlo Integration"
                 ],
-                "summary": "Get Trapezoid",
+                "summary": "Get Monte Carlo Integration Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trapezoid ID",
+                        "description": "Monte Carlo Integration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -159,7 +165,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Trapezoid"
+                            "$ref": "#/definitions/models.MonteCarlo"
                         }
                     },
                     "400": {
@@ -167,13 +173,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/numerical-method/interpolation/linear-newton": {
+        "/numerical-method/integration/multiple": {
             "post": {
-                "description": "Create the linear newton data",
+                "description": "Integrate over x in [x_lower, x_upper], y between y_lower(x) and y_upper(x) and, for a triple integral, z between z_lower(x, y) and z_upper(x, y), with the composite trapezoid or Simpson rule or Gauss-Legendre points in each dimension",
                 "consumes": [
                     "application/json"
                 ],
@@ -181,9 +193,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Linear Newton"
+                    "Multiple Integral"
                 ],
-                "summary": "Create Linear Newton",
+                "summary": "Create Multiple Integral Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -191,7 +203,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqLinearNewton"
+                            "$ref": "#/definitions/validations.ReqMultipleIntegral"
                         }
                     }
                 ],
@@ -199,7 +211,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.LinearNewton"
+                            "$ref": "#/definitions/models.MultipleIntegral"
                         }
                     },
                     "400": {
@@ -211,9 +223,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/linear-newton/{id}": {
+        "/numerical-method/integration/multiple/{id}": {
             "get": {
-                "description": "Get the linear newton data",
+                "description": "Get the multiple integral result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -221,13 +233,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Linear Newton"
+                    "Multiple Integral"
                 ],
-                "summary": "Get Linear Newton",
+                "summary": "Get Multiple Integral Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Multiple Integral ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -237,7 +249,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LinearNewton"
+                            "$ref": "#/definitions/models.MultipleIntegral"
                         }
                     },
                     "400": {
@@ -245,13 +257,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/numerical-method/interpolation/polynomial-newton": {
+        "/numerical-method/integration/simpson": {
             "post": {
-                "description": "Create the polynomial newton data",
+                "description": "Create the simpson data; with precision (bits) set, the composite rule is evaluated in float64 and big.Float and compared",
                 "consumes": [
                     "application/json"
                 ],
@@ -259,9 +277,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Polynomial Newton"
+                    "Simpson"
                 ],
-                "summary": "Create Polynomial Newton",
+                "summary": "Create Simpson",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -269,15 +287,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqPolynomialNewton"
+                            "$ref": "#/definitions/validations.ReqSimpson"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PolynomialNewton"
+                            "$ref": "#/definitions/models.Simpson"
                         }
                     },
                     "400": {
@@ -289,9 +307,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/polynomial-newton/{id}": {
+        "/numerical-method/integration/simpson/{id}": {
             "get": {
-                "description": "Get the polynomial newton data",
+                "description": "Get the simpson data",
                 "consumes": [
                     "application/json"
                 ],
@@ -299,13 +317,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Polynomial Newton"
+                    "Simpson"
                 ],
-                "summary": "Get Polynomial Newton",
+                "summary": "Get Simpson",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Simpson ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -315,7 +333,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PolynomialNewton"
+                            "$ref": "#/definitions/models.Simpson"
                         }
                     },
                     "400": {
@@ -327,9 +345,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-lagrange": {
+        "/numerical-method/integration/trapezoid": {
             "post": {
-                "description": "Create the quadratic lagrange data",
+                "description": "Create the trapezoid data; with precision (bits) set, the composite rule is evaluated in float64 and big.Float and compared",
                 "consumes": [
                     "application/json"
                 ],
@@ -337,9 +355,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Lagrange"
+                    "Trapezoid"
                 ],
-                "summary": "Create Quadratic Lagrange",
+                "summary": "Create Trapezoid",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -347,15 +365,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqQuadraticLagrange"
+                            "$ref": "#/definitions/validations.ReqTrapezoid"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticLagrange"
+                            "$ref": "#/definitions/models.Trapezoid"
                         }
                     },
                     "400": {
@@ -367,9 +385,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-lagrange/{id}": {
+        "/numerical-method/integration/trapezoid/{id}": {
             "get": {
-                "description": "Get the quadratic lagrange data",
+                "description": "Get the trapezoid data",
                 "consumes": [
                     "application/json"
                 ],
@@ -377,13 +395,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Lagrange"
+                    "Trapezoid"
                 ],
-                "summary": "Get Quadratic Lagrange",
+                "summary": "Get Trapezoid",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Trapezoid ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -393,7 +411,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticLagrange"
+                            "$ref": "#/definitions/models.Trapezoid"
                         }
                     },
                     "400": {
@@ -405,9 +423,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-newton": {
+        "/numerical-method/interpolation/bivariate": {
             "post": {
-                "description": "Create the quadratic newton data",
+                "description": "Create the bilinear or bicubic interpolation of a grid of z = f(x, y) at the query points",
                 "consumes": [
                     "application/json"
                 ],
@@ -415,9 +433,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Newton"
+                    "Bivariate"
                 ],
-                "summary": "Create Quadratic Newton",
+                "summary": "Create Bivariate Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -425,7 +443,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqQuadraticNewton"
+                            "$ref": "#/definitions/validations.ReqBivariate"
                         }
                     }
                 ],
@@ -433,7 +451,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticNewton"
+                            "$ref": "#/definitions/models.Bivariate"
                         }
                     },
                     "400": {
@@ -445,9 +463,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-newton/{id}": {
+        "/numerical-method/interpolation/bivariate/{id}": {
             "get": {
-                "description": "Get the quadratic newton data",
+                "description": "Get the bivariate interpolation result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -455,13 +473,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Newton"
+                    "Bivariate"
                 ],
-                "summary": "Get Quadratic Newton",
+                "summary": "Get Bivariate Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Bivariate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -471,7 +489,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticNewton"
+                            "$ref": "#/definitions/models.Bivariate"
                         }
                     },
                     "400": {
@@ -479,13 +497,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-spline": {
+        "/numerical-method/interpolation/chebyshev": {
             "post": {
-                "description": "Create the quadratic spline data",
+                "description": "Create the Chebyshev interpolation of a function, adaptive when only a tolerance is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -493,9 +517,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Spline"
+                    "Chebyshev"
                 ],
-                "summary": "Create Quadratic Spline",
+                "summary": "Create Chebyshev Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -503,7 +527,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqQuadraticSpline"
+                            "$ref": "#/definitions/validations.ReqChebyshev"
                         }
                     }
                 ],
@@ -511,7 +535,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticSpline"
+                            "$ref": "#/definitions/models.Chebyshev"
                         }
                     },
                     "400": {
@@ -523,9 +547,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/interpolation/quadratic-spline/{id}": {
+        "/numerical-method/interpolation/chebyshev/{id}": {
             "get": {
-                "description": "Get the quadratic spline data",
+                "description": "Get the Chebyshev interpolation result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -533,13 +557,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Quadratic Spline"
+                    "Chebyshev"
                 ],
-                "summary": "Get Quadratic Spline",
+                "summary": "Get Chebyshev Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Chebyshev ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -549,7 +573,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QuadraticSpline"
+                            "$ref": "#/definitions/models.Chebyshev"
                         }
                     },
                     "400": {
@@ -557,13 +581,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/numerical-method/least-squares-regression/linear-regression": {
+        "/numerical-method/interpolation/fft": {
             "post": {
-                "description": "Create the linear regression result",
+                "description": "Create the FFT spectrum of sampled data or a sampled function and evaluate its trigonometric interpolant",
                 "consumes": [
                     "application/json"
                 ],
@@ -571,9 +601,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Linear Regression"
+                    "FFT"
                 ],
-                "summary": "Create Linear Regression Result",
+                "summary": "Create FFT Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -581,7 +611,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqLinearRegression"
+                            "$ref": "#/definitions/validations.ReqFFT"
                         }
                     }
                 ],
@@ -589,7 +619,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.LinearRegression"
+                            "$ref": "#/definitions/models.FFT"
                         }
                     },
                     "400": {
@@ -601,9 +631,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/least-squares-regression/linear-regression/{id}": {
+        "/numerical-method/interpolation/fft/{id}": {
             "get": {
-                "description": "Get the linear regression result by ID",
+                "description": "Get the FFT result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -611,13 +641,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Linear Regression"
+                    "FFT"
                 ],
-                "summary": "Get Linear Regression Result",
+                "summary": "Get FFT Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Linear Regression ID",
+                        "description": "FFT ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -627,7 +657,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LinearRegression"
+                            "$ref": "#/definitions/models.FFT"
                         }
                     },
                     "400": {
@@ -645,9 +675,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/least-squares-regression/multiple-regression": {
+        "/numerical-method/interpolation/floater-hormann": {
             "post": {
-                "description": "Create the multiple regression result",
+                "description": "Create the Floater-Hormann rational interpolation of the points",
                 "consumes": [
                     "application/json"
                 ],
@@ -655,9 +685,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Multiple Regression"
+                    "Floater-Hormann"
                 ],
-                "summary": "Create Multiple Regression Result",
+                "summary": "Create Floater-Hormann Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -665,7 +695,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqMultipleRegression"
+                            "$ref": "#/definitions/validations.ReqFloaterHormann"
                         }
                     }
                 ],
@@ -673,7 +703,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.MultipleRegression"
+                            "$ref": "#/definitions/models.FloaterHormann"
                         }
                     },
                     "400": {
@@ -685,9 +715,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/least-squares-regression/multiple-regression/{id}": {
+        "/numerical-method/interpolation/floater-hormann/{id}": {
             "get": {
-                "description": "Get the multiple regression result by ID",
+                "description": "Get the Floater-Hormann rational interpolation result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -695,13 +725,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Multiple Regression"
+                    "Floater-Hormann"
                 ],
-                "summary": "Get Multiple Regression Result",
+                "summary": "Get Floater-Hormann Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Multiple Regression ID",
+                        "description": "Floater-Hormann ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -711,7 +741,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MultipleRegression"
+                            "$ref": "#/definitions/models.FloaterHormann"
                         }
                     },
                     "400": {
@@ -729,9 +759,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/least-squares-regression/polynomial-regression": {
+        "/numerical-method/interpolation/hermite": {
             "post": {
-                "description": "Create the polynomial regression result",
+                "description": "Create the Hermite interpolation of points with derivatives, or the monotone PCHIP interpolation",
                 "consumes": [
                     "application/json"
                 ],
@@ -739,9 +769,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Polynomial Regression"
+                    "Hermite"
                 ],
-                "summary": "Create Polynomial Regression Result",
+                "summary": "Create Hermite Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -749,7 +779,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqPolynomialRegression"
+                            "$ref": "#/definitions/validations.ReqHermite"
                         }
                     }
                 ],
@@ -757,7 +787,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PolynomialRegression"
+                            "$ref": "#/definitions/models.Hermite"
                         }
                     },
                     "400": {
@@ -769,9 +799,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/least-squares-regression/polynomial-regression/{id}": {
+        "/numerical-method/interpolation/hermite/{id}": {
             "get": {
-                "description": "Get the polynomial regression result by ID",
+                "description": "Get the Hermite interpolation result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -779,13 +809,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Polynomial Regression"
+                    "Hermite"
                 ],
-                "summary": "Get Polynomial Regression Result",
+                "summary": "Get Hermite Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Polynomial Regression ID",
+                        "description": "Hermite ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -795,7 +825,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PolynomialRegression"
+                            "$ref": "#/definitions/models.Hermite"
                         }
                     },
                     "400": {
@@ -813,9 +843,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/linear-algrebra/matrix": {
+        "/numerical-method/interpolation/linear-newton": {
             "post": {
-                "description": "Create the matrix result",
+                "description": "Create the linear newton data",
                 "consumes": [
                     "application/json"
                 ],
@@ -823,9 +853,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Matrix"
+                    "Linear Newton"
                 ],
-                "summary": "Create Matrix Result",
+                "summary": "Create Linear Newton",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -833,7 +863,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqMatrix"
+                            "$ref": "#/definitions/validations.ReqLinearNewton"
                         }
                     }
                 ],
@@ -841,7 +871,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Matrix"
+                            "$ref": "#/definitions/models.LinearNewton"
                         }
                     },
                     "400": {
@@ -853,9 +883,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/linear-algrebra/matrix-iteration": {
+        "/numerical-method/interpolation/linear-newton/{id}": {
+            "get": {
+                "description": "Get the linear newton data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Linear Newton"
+                ],
+                "summary": "Get Linear Newton",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LinearNewton"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/interpolation/pade": {
             "post": {
-                "description": "Create the matrix iteration result",
+                "description": "Create the [m/n] Padé approximant of a function from its Taylor coefficients",
                 "consumes": [
                     "application/json"
                 ],
@@ -863,9 +931,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Matrix Iteration"
+                    "Pade"
                 ],
-                "summary": "Create Matrix Iteration Result",
+                "summary": "Create Pade Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -873,7 +941,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqMatrixIteration"
+                            "$ref": "#/definitions/validations.ReqPade"
                         }
                     }
                 ],
@@ -881,7 +949,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.MatrixIteration"
+                            "$ref": "#/definitions/models.Pade"
                         }
                     },
                     "400": {
@@ -893,9 +961,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/linear-algrebra/matrix-iteration/{id}": {
+        "/numerical-method/interpolation/pade/{id}": {
             "get": {
-                "description": "Get the matrix iteration result by ID",
+                "description": "Get the Padé approximant result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -903,13 +971,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Matrix Iteration"
+                    "Pade"
                 ],
-                "summary": "Get Matrix Iteration Result",
+                "summary": "Get Pade Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Matrix Iteration ID",
+                        "description": "Pade ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -919,7 +987,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graphical"
+                            "$ref": "#/definitions/models.Pade"
                         }
                     },
                     "400": {
@@ -937,9 +1005,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/linear-algrebra/matrix/{id}": {
-            "get": {
-                "description": "Get the matrix result by ID",
+        "/numerical-method/interpolation/polynomial-newton": {
+            "post": {
+                "description": "Create the polynomial newton data",
                 "consumes": [
                     "application/json"
                 ],
@@ -947,13 +1015,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Matrix"
+                    "Polynomial Newton"
                 ],
-                "summary": "Get Matrix Result",
+                "summary": "Create Polynomial Newton",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqPolynomialNewton"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PolynomialNewton"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/interpolation/polynomial-newton/{id}": {
+            "get": {
+                "description": "Get the polynomial newton data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polynomial Newton"
+                ],
+                "summary": "Get Polynomial Newton",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Matrix ID",
+                        "description": "ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -963,7 +1071,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graphical"
+                            "$ref": "#/definitions/models.PolynomialNewton"
                         }
                     },
                     "400": {
@@ -971,19 +1079,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/numerical-diff": {
+        "/numerical-method/interpolation/quadratic-lagrange": {
             "post": {
-                "description": "Create numerical diff",
+                "description": "Create the quadratic lagrange data",
                 "consumes": [
                     "application/json"
                 ],
@@ -991,9 +1093,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "numerical-diff"
+                    "Quadratic Lagrange"
                 ],
-                "summary": "Create numerical diff",
+                "summary": "Create Quadratic Lagrange",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1001,7 +1103,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqNumericalDiff"
+                            "$ref": "#/definitions/validations.ReqQuadraticLagrange"
                         }
                     }
                 ],
@@ -1009,7 +1111,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.NumericalDiff"
+                            "$ref": "#/definitions/models.QuadraticLagrange"
                         }
                     },
                     "400": {
@@ -1017,19 +1119,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/numerical-diff/{id}": {
+        "/numerical-method/interpolation/quadratic-lagrange/{id}": {
             "get": {
-                "description": "Get numerical diff by id",
+                "description": "Get the quadratic lagrange data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1037,13 +1133,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "numerical-diff"
+                    "Quadratic Lagrange"
                 ],
-                "summary": "Get numerical diff",
+                "summary": "Get Quadratic Lagrange",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Numerical diff id",
+                        "description": "ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1053,7 +1149,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.NumericalDiff"
+                            "$ref": "#/definitions/models.QuadraticLagrange"
                         }
                     },
                     "400": {
@@ -1061,25 +1157,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/bisection": {
+        "/numerical-method/interpolation/quadratic-newton": {
             "post": {
-                "description": "Create the Bisection method",
+                "description": "Create the quadratic newton data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1087,9 +1171,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bisection"
+                    "Quadratic Newton"
                 ],
-                "summary": "Create Bisection Method Result",
+                "summary": "Create Quadratic Newton",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1097,15 +1181,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqBisection"
+                            "$ref": "#/definitions/validations.ReqQuadraticNewton"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Bisection"
+                            "$ref": "#/definitions/models.QuadraticNewton"
                         }
                     },
                     "400": {
@@ -1113,19 +1197,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/bisection/{id}": {
+        "/numerical-method/interpolation/quadratic-newton/{id}": {
             "get": {
-                "description": "Get the Bisection method result by ID",
+                "description": "Get the quadratic newton data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1133,13 +1211,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bisection"
+                    "Quadratic Newton"
                 ],
-                "summary": "Get Bisection Method Result",
+                "summary": "Get Quadratic Newton",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bisection ID",
+                        "description": "ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1149,7 +1227,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Bisection"
+                            "$ref": "#/definitions/models.QuadraticNewton"
                         }
                     },
                     "400": {
@@ -1157,19 +1235,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/false-position": {
+        "/numerical-method/interpolation/quadratic-spline": {
             "post": {
-                "description": "Create the FalsePosition method",
+                "description": "Create the quadratic spline data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1177,9 +1249,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FalsePosition"
+                    "Quadratic Spline"
                 ],
-                "summary": "Create FalsePosition Method Result",
+                "summary": "Create Quadratic Spline",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1187,15 +1259,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqFalsePosition"
+                            "$ref": "#/definitions/validations.ReqQuadraticSpline"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.FalsePosition"
+                            "$ref": "#/definitions/models.QuadraticSpline"
                         }
                     },
                     "400": {
@@ -1203,19 +1275,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/false-position/{id}": {
+        "/numerical-method/interpolation/quadratic-spline/{id}": {
             "get": {
-                "description": "Get the FalsePosition method result by ID",
+                "description": "Get the quadratic spline data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1223,13 +1289,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FalsePosition"
+                    "Quadratic Spline"
                 ],
-                "summary": "Get FalsePosition Method Result",
+                "summary": "Get Quadratic Spline",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "FalsePosition ID",
+                        "description": "ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1239,7 +1305,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.FalsePosition"
+                            "$ref": "#/definitions/models.QuadraticSpline"
                         }
                     },
                     "400": {
@@ -1247,19 +1313,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/graphical": {
+        "/numerical-method/least-squares-regression/b-spline-regression": {
             "post": {
-                "description": "Create the graphical method",
+                "description": "Fit a spline with the given degree and knots to the points by least squares in the B-spline basis",
                 "consumes": [
                     "application/json"
                 ],
@@ -1267,9 +1327,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Graphical"
+                    "B-Spline Regression"
                 ],
-                "summary": "Create Graphical Method Result",
+                "summary": "Create B-Spline Regression Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1277,15 +1337,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqGraphical"
+                            "$ref": "#/definitions/validations.ReqBSplineRegression"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Graphical"
+                            "$ref": "#/definitions/models.BSplineRegression"
                         }
                     },
                     "400": {
@@ -1293,19 +1353,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/graphical/{id}": {
+        "/numerical-method/least-squares-regression/b-spline-regression/{id}": {
             "get": {
-                "description": "Get the graphical method result by ID",
+                "description": "Get the B-spline regression result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1313,13 +1367,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Graphical"
+                    "B-Spline Regression"
                 ],
-                "summary": "Get Graphical Method Result",
+                "summary": "Get B-Spline Regression Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Graphical ID",
+                        "description": "B-Spline Regression ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1329,7 +1383,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Graphical"
+                            "$ref": "#/definitions/models.BSplineRegression"
                         }
                     },
                     "400": {
@@ -1347,9 +1401,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/root-of-equations/newton-raphson": {
+        "/numerical-method/least-squares-regression/linear-regression": {
             "post": {
-                "description": "Create the NewtonRaphson method",
+                "description": "Create the linear regression result",
                 "consumes": [
                     "application/json"
                 ],
@@ -1357,9 +1411,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NewtonRaphson"
+                    "Linear Regression"
                 ],
-                "summary": "Create NewtonRaphson Method Result",
+                "summary": "Create Linear Regression Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1367,15 +1421,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqNewtonRaphson"
+                            "$ref": "#/definitions/validations.ReqLinearRegression"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.FalsePosition"
+                            "$ref": "#/definitions/models.LinearRegression"
                         }
                     },
                     "400": {
@@ -1383,19 +1437,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/newton-raphson/{id}": {
+        "/numerical-method/least-squares-regression/linear-regression/{id}": {
             "get": {
-                "description": "Get the NewtonRaphson method result by ID",
+                "description": "Get the linear regression result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1403,13 +1451,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NewtonRaphson"
+                    "Linear Regression"
                 ],
-                "summary": "Get NewtonRaphson Method Result",
+                "summary": "Get Linear Regression Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NewtonRaphson ID",
+                        "description": "Linear Regression ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1419,7 +1467,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.NewtonRaphson"
+                            "$ref": "#/definitions/models.LinearRegression"
                         }
                     },
                     "400": {
@@ -1437,9 +1485,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/root-of-equations/one-point": {
+        "/numerical-method/least-squares-regression/multiple-regression": {
             "post": {
-                "description": "Create the OnePoint method",
+                "description": "Create the multiple regression result",
                 "consumes": [
                     "application/json"
                 ],
@@ -1447,9 +1495,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "OnePoint"
+                    "Multiple Regression"
                 ],
-                "summary": "Create OnePoint Method Result",
+                "summary": "Create Multiple Regression Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1457,15 +1505,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqOnePoint"
+                            "$ref": "#/definitions/validations.ReqMultipleRegression"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.FalsePosition"
+                            "$ref": "#/definitions/models.MultipleRegression"
                         }
                     },
                     "400": {
@@ -1473,19 +1521,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/numerical-method/root-of-equations/one-point/{id}": {
+        "/numerical-method/least-squares-regression/multiple-regression/{id}": {
             "get": {
-                "description": "Get the OnePoint method result by ID",
+                "description": "Get the multiple regression result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1493,13 +1535,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "OnePoint"
+                    "Multiple Regression"
                 ],
-                "summary": "Get OnePoint Method Result",
+                "summary": "Get Multiple Regression Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "OnePoint ID",
+                        "description": "Multiple Regression ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1509,7 +1551,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.OnePoint"
+                            "$ref": "#/definitions/models.MultipleRegression"
                         }
                     },
                     "400": {
@@ -1527,9 +1569,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/root-of-equations/secant": {
+        "/numerical-method/least-squares-regression/nonlinear-regression": {
             "post": {
-                "description": "Create the Secant method",
+                "description": "Fit a model that is nonlinear in its parameters, such as a*exp(b*x)+c, to the points with the Levenberg-Marquardt method",
                 "consumes": [
                     "application/json"
                 ],
@@ -1537,9 +1579,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Secant"
+                    "Nonlinear Regression"
                 ],
-                "summary": "Create Secantn Method Result",
+                "summary": "Create Nonlinear Regression Result",
                 "parameters": [
                     {
                         "description": "Request Body",
@@ -1547,15 +1589,53 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/validations.ReqSecant"
+                            "$ref": "#/definitions/validations.ReqNonlinearRegression"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.NonlinearRegression"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/numerical-method/least-squares-regression/nonlinear-regression/{id}": {
+            "get": {
+                "description": "Get the nonlinear regression result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nonlinear Regression"
+                ],
+                "summary": "Get Nonlinear Regression Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nonlinear Regression ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Secant"
+                            "$ref": "#/definitions/models.NonlinearRegression"
                         }
                     },
                     "400": {
@@ -1573,9 +1653,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/numerical-method/root-of-equations/secant/{id}": {
+        "/numerical-method/least-squares-regression/polynomial-regression": {
+            "post": {
+                "description": "Create the polynomial regression result",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polynomial Regression"
+                ],
+                "summary": "Create Polynomial Regression Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqPolynomialRegression"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PolynomialRegression"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/least-squares-regression/polynomial-regression/{id}": {
             "get": {
-                "description": "Get the Secant method result by ID",
+                "description": "Get the polynomial regression result by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1583,13 +1703,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Secant"
+                    "Polynomial Regression"
                 ],
-                "summary": "Get Secant Method Result",
+                "summary": "Get Polynomial Regression Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Secant ID",
+                        "description": "Polynomial Regression ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1599,7 +1719,91 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Secant"
+                            "$ref": "#/definitions/models.PolynomialRegression"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/least-squares-regression/smoothing-spline": {
+            "post": {
+                "description": "Fit the cubic smoothing spline to the points, choosing lambda by GCV when it is 0",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Smoothing Spline"
+                ],
+                "summary": "Create Smoothing Spline Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqSmoothingSpline"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SmoothingSpline"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/least-squares-regression/smoothing-spline/{id}": {
+            "get": {
+                "description": "Get the smoothing spline result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Smoothing Spline"
+                ],
+                "summary": "Get Smoothing Spline Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Smoothing Spline ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SmoothingSpline"
                         }
                     },
                     "400": {
@@ -1616,10 +1820,5171 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Bisection": {
+        },
+        "/numerical-method/linear-algrebra/bicgstab": {
+            "post": {
+                "description": "Solve a sparse system given as COO triplets \"row col value, ...\" with BiCGSTAB; when constant_data is empty b = A*ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BiCGSTAB"
+                ],
+                "summary": "Create BiCGSTAB Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBiCGSTAB"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BiCGSTAB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/bicgstab/upload": {
+            "post": {
+                "description": "Solve a sparse system uploaded as a Matrix Market (.mtx) coordinate file with BiCGSTAB",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BiCGSTAB"
+                ],
+                "summary": "Upload BiCGSTAB Matrix Market File",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Matrix Market (.mtx) file",
+                        "name": "matrix",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated right-hand side, defaults to A*ones",
+                        "name": "constant_data",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Relative residual tolerance",
+                        "name": "e",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BiCGSTAB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/bicgstab/{id}": {
+            "get": {
+                "description": "Get the bi CGSTAB result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BiCGSTAB"
+                ],
+                "summary": "Get BiCGSTAB Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "BiCGSTAB ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BiCGSTAB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/gmres": {
+            "post": {
+                "description": "Solve a sparse system given as COO triplets \"row col value, ...\" with restarted GMRES(m); when constant_data is empty b = A*ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GMRES"
+                ],
+                "summary": "Create GMRES Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqGMRES"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GMRES"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/gmres/upload": {
+            "post": {
+                "description": "Solve a sparse system uploaded as a Matrix Market (.mtx) coordinate file with restarted GMRES(m)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GMRES"
+                ],
+                "summary": "Upload GMRES Matrix Market File",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Matrix Market (.mtx) file",
+                        "name": "matrix",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated right-hand side, defaults to A*ones",
+                        "name": "constant_data",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Relative residual tolerance",
+                        "name": "e",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Restart length m, defaults to 30",
+                        "name": "restart",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GMRES"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/gmres/{id}": {
+            "get": {
+                "description": "Get the GMRES result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GMRES"
+                ],
+                "summary": "Get GMRES Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GMRES ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GMRES"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix": {
+            "post": {
+                "description": "Create the matrix result; with precision (bits) set, Gaussian elimination is run in float64 and big.Float and compared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix"
+                ],
+                "summary": "Create Matrix Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqMatrix"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Matrix"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix-iteration": {
+            "post": {
+                "description": "Create the matrix iteration result",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix Iteration"
+                ],
+                "summary": "Create Matrix Iteration Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqMatrixIteration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MatrixIteration"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix-iteration/{id}": {
+            "get": {
+                "description": "Get the matrix iteration result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix Iteration"
+                ],
+                "summary": "Get Matrix Iteration Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Graphical"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix-iteration/{id}/pcg": {
+            "get": {
+                "description": "Solve a stored symmetric positive definite matrix iteration problem with conjugate gradients using no, Jacobi, SSOR and IC(0) preconditioning, reporting the iterations to tolerance e of each; x comes from the selected preconditioner (default ic0)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix Iteration"
+                ],
+                "summary": "Get PCG Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "none, jacobi, ssor or ic0",
+                        "name": "preconditioner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "SSOR relaxation factor (default 1)",
+                        "name": "omega",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PCG"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix-iteration/{id}/sor": {
+            "get": {
+                "description": "Solve a stored matrix iteration problem with SOR or SSOR using the given omega, or for SOR with auto the optimal omega from the Jacobi spectral radius, and compare iteration counts for omega = 0.1 ... 1.9",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix Iteration"
+                ],
+                "summary": "Get SOR Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "sor or ssor (default sor)",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "relaxation factor between 0 and 2",
+                        "name": "omega",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "use the optimal omega (sor only)",
+                        "name": "auto",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SOR"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix/{id}": {
+            "get": {
+                "description": "Get the matrix result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix"
+                ],
+                "summary": "Get Matrix Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Graphical"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix/{id}/analysis": {
+            "get": {
+                "description": "Solve a stored matrix problem with LU and return estimates of kappa_1 and kappa_inf, the residual ||b - Ax||_inf, the normwise backward error and the forward error bound, plus mixed precision iterative refinement (float32 LU, float64 residual) with the error relative to the float64 solution after each step",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix"
+                ],
+                "summary": "Get Matrix Analysis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MatrixAnalysis"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/linear-algrebra/matrix/{id}/qr": {
+            "get": {
+                "description": "Factor a stored matrix as A = QR with classical and modified Gram-Schmidt, Householder and Givens (or only the selected method), returning Q, R, the orthogonality loss ||Q^T Q - I|| and the solution of Ax = b",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matrix"
+                ],
+                "summary": "Get QR Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "cgs, mgs, householder or givens",
+                        "name": "method",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QR"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/numerical-diff": {
+            "post": {
+                "description": "Differentiate the function at x with automatic differentiation (dual numbers for order 1, hyper-dual numbers for order 2, Taylor series above) and compare the forward, backward, central and complex-step estimates against it; an input that cannot be solved is still stored, with error set instead of result",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "numerical-diff"
+                ],
+                "summary": "Create numerical diff",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqNumericalDiff"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.NumericalDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/numerical-diff/symbolic": {
+            "post": {
+                "description": "Differentiate the function symbolically up to the given order and return each simplified derivative with its value at x; if an order grows too long, the lower orders are returned with a warning",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "numerical-diff"
+                ],
+                "summary": "Create symbolic diff",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqSymbolicDiff"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SymbolicDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/numerical-diff/symbolic/{id}": {
+            "get": {
+                "description": "Get the symbolic differentiation result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "numerical-diff"
+                ],
+                "summary": "Get symbolic diff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "numerical-diff ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SymbolicDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/numerical-diff/{id}": {
+            "get": {
+                "description": "Get numerical diff by id, with the result or, when the input cannot be solved, the error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "numerical-diff"
+                ],
+                "summary": "Get numerical diff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Numerical diff id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NumericalDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/ode/bvp": {
+            "post": {
+                "description": "Solve d^2y/dx^2 = f(x, y, y') with y(a) = alpha and y(b) = beta by the shooting method or finite differences and return the solution table and iteration log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boundary Value Problem"
+                ],
+                "summary": "Create Boundary Value Problem Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBVP"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BVP"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/ode/bvp/{id}": {
+            "get": {
+                "description": "Get the boundary value problem result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boundary Value Problem"
+                ],
+                "summary": "Get Boundary Value Problem Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Boundary Value Problem ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BVP"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/bfgs": {
+            "post": {
+                "description": "Minimize (or maximize with goal \"max\") f from x0 with the BFGS quasi-Newton method",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BFGS"
+                ],
+                "summary": "Create BFGS Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBFGS"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BFGS"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/bfgs/{id}": {
+            "get": {
+                "description": "Get the BFGS result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BFGS"
+                ],
+                "summary": "Get BFGS Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "BFGS ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BFGS"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/golden-section": {
+            "post": {
+                "description": "Find the minimum (or maximum with goal \"max\") of f(x) on [xl, xr] by golden-section search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Golden Section"
+                ],
+                "summary": "Create Golden Section Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqGoldenSection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GoldenSection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/golden-section/{id}": {
+            "get": {
+                "description": "Get the golden section result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Golden Section"
+                ],
+                "summary": "Get Golden Section Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GoldenSection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GoldenSection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/gradient-descent": {
+            "post": {
+                "description": "Minimize (or maximize with goal \"max\") f from x0 by gradient descent with a fixed step, or a line search when step is 0",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gradient Descent"
+                ],
+                "summary": "Create Gradient Descent Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqGradientDescent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GradientDescent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/gradient-descent/{id}": {
+            "get": {
+                "description": "Get the gradient descent result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gradient Descent"
+                ],
+                "summary": "Get Gradient Descent Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GradientDescent ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GradientDescent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/nelder-mead": {
+            "post": {
+                "description": "Minimize (or maximize with goal \"max\") f from x0 with the Nelder-Mead simplex method",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nelder Mead"
+                ],
+                "summary": "Create Nelder Mead Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqNelderMead"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.NelderMead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/nelder-mead/{id}": {
+            "get": {
+                "description": "Get the nelder mead result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nelder Mead"
+                ],
+                "summary": "Get Nelder Mead Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NelderMead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NelderMead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/parabolic-interpolation": {
+            "post": {
+                "description": "Find the minimum (or maximum with goal \"max\") of f(x) on [xl, xr] by successive parabolic interpolation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parabolic Interpolation"
+                ],
+                "summary": "Create Parabolic Interpolation Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqParabolicInterpolation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParabolicInterpolation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/optimization/parabolic-interpolation/{id}": {
+            "get": {
+                "description": "Get the parabolic interpolation result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parabolic Interpolation"
+                ],
+                "summary": "Get Parabolic Interpolation Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ParabolicInterpolation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParabolicInterpolation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/heat": {
+            "post": {
+                "description": "Solve u_t = alpha u_xx with the FTCS, BTCS or Crank-Nicolson scheme and return the solution at the requested times",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Heat Equation"
+                ],
+                "summary": "Create Heat Equation Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqHeatEquation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.HeatEquation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/heat/{id}": {
+            "get": {
+                "description": "Get the heat equation result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Heat Equation"
+                ],
+                "summary": "Get Heat Equation Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Heat Equation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HeatEquation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/poisson": {
+            "post": {
+                "description": "Solve u_xx + u_yy = f on a rectangle with five point finite differences by Jacobi, Gauss-Seidel, SOR or multigrid and return the grid solution and residual history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Poisson Equation"
+                ],
+                "summary": "Create Poisson Equation Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqPoisson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Poisson"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/poisson/{id}": {
+            "get": {
+                "description": "Get the Poisson equation result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Poisson Equation"
+                ],
+                "summary": "Get Poisson Equation Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poisson Equation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Poisson"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/wave": {
+            "post": {
+                "description": "Solve u_tt = c^2 u_xx with the leapfrog scheme and return the solution at the requested times",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wave Equation"
+                ],
+                "summary": "Create Wave Equation Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqWaveEquation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaveEquation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/pde/wave/{id}": {
+            "get": {
+                "description": "Get the wave equation result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wave Equation"
+                ],
+                "summary": "Get Wave Equation Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wave Equation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaveEquation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/bisection": {
+            "post": {
+                "description": "Create the Bisection method; with precision (bits) set, it is also run in big.Float and compared with float64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bisection"
+                ],
+                "summary": "Create Bisection Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBisection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bisection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/bisection/{id}": {
+            "get": {
+                "description": "Get the Bisection method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bisection"
+                ],
+                "summary": "Get Bisection Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bisection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Bisection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/brent": {
+            "post": {
+                "description": "Solve the equation on the bracket [xl, xr] with the Brent's method, stopping when the relative approximate error (%) is below e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Brent"
+                ],
+                "summary": "Create Brent Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBisection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Brent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/brent/{id}": {
+            "get": {
+                "description": "Get the Brent's method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Brent"
+                ],
+                "summary": "Get Brent Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Brent ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Brent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/false-position": {
+            "post": {
+                "description": "Create the FalsePosition method; with precision (bits) set, it is also run in big.Float and compared with float64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FalsePosition"
+                ],
+                "summary": "Create FalsePosition Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqFalsePosition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FalsePosition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/false-position/{id}": {
+            "get": {
+                "description": "Get the FalsePosition method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FalsePosition"
+                ],
+                "summary": "Get FalsePosition Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "FalsePosition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FalsePosition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/graphical": {
+            "post": {
+                "description": "Create the graphical method",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Graphical"
+                ],
+                "summary": "Create Graphical Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqGraphical"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Graphical"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/graphical/{id}": {
+            "get": {
+                "description": "Get the graphical method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Graphical"
+                ],
+                "summary": "Get Graphical Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Graphical ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Graphical"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/interval-newton": {
+            "post": {
+                "description": "Enclose every root of the equation in [xl, xr] with interval Newton steps and bisection, refining each enclosure until its relative width (%) is below e, or prove that there is no root",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interval Newton"
+                ],
+                "summary": "Create Interval Newton Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBisection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.IntervalNewton"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/interval-newton/{id}": {
+            "get": {
+                "description": "Get the interval Newton result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interval Newton"
+                ],
+                "summary": "Get Interval Newton Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Interval Newton ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.IntervalNewton"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/itp": {
+            "post": {
+                "description": "Solve the equation on the bracket [xl, xr] with the ITP method, stopping when the relative approximate error (%) is below e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITP"
+                ],
+                "summary": "Create ITP Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBisection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ITP"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/itp/{id}": {
+            "get": {
+                "description": "Get the ITP method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITP"
+                ],
+                "summary": "Get ITP Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ITP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ITP"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/newton-raphson": {
+            "post": {
+                "description": "Create the NewtonRaphson method; with precision (bits) set, it is also run in big.Float and compared with float64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NewtonRaphson"
+                ],
+                "summary": "Create NewtonRaphson Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqNewtonRaphson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FalsePosition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/newton-raphson/{id}": {
+            "get": {
+                "description": "Get the NewtonRaphson method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NewtonRaphson"
+                ],
+                "summary": "Get NewtonRaphson Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NewtonRaphson ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.NewtonRaphson"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/one-point": {
+            "post": {
+                "description": "Create the OnePoint method; with precision (bits) set, it is also run in big.Float and compared with float64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OnePoint"
+                ],
+                "summary": "Create OnePoint Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqOnePoint"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FalsePosition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/one-point/{id}": {
+            "get": {
+                "description": "Get the OnePoint method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OnePoint"
+                ],
+                "summary": "Get OnePoint Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OnePoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OnePoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/polynomial": {
+            "post": {
+                "description": "Find all complex roots of a polynomial given as an equation in x or as coefficients (highest degree first)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PolynomialRoots"
+                ],
+                "summary": "Create Polynomial Roots Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqPolynomialRoots"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PolynomialRoots"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/polynomial/{id}": {
+            "get": {
+                "description": "Get all complex roots of the stored polynomial by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PolynomialRoots"
+                ],
+                "summary": "Get Polynomial Roots Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PolynomialRoots ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PolynomialRoots"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/ridders": {
+            "post": {
+                "description": "Solve the equation on the bracket [xl, xr] with the Ridders' method, stopping when the relative approximate error (%) is below e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ridders"
+                ],
+                "summary": "Create Ridders Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqBisection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Ridders"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/ridders/{id}": {
+            "get": {
+                "description": "Get the Ridders' method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ridders"
+                ],
+                "summary": "Get Ridders Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ridders ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Ridders"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/secant": {
+            "post": {
+                "description": "Create the Secant method; with precision (bits) set, it is also run in big.Float and compared with float64",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secant"
+                ],
+                "summary": "Create Secantn Method Result",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/validations.ReqSecant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Secant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/numerical-method/root-of-equations/secant/{id}": {
+            "get": {
+                "description": "Get the Secant method result by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secant"
+                ],
+                "summary": "Get Secant Method Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Secant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.BFGS": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.OptimizationResult"
+                },
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BSplineRegression": {
+            "type": "object",
+            "properties": {
+                "degree": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "knots": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.SplineFitResult"
+                },
+                "segments": {
+                    "type": "integer"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BVP": {
+            "type": "object",
+            "properties": {
+                "alpha": {
+                    "type": "number"
+                },
+                "beta": {
+                    "type": "number"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "exact": {
+                    "type": "string"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "intervals": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.BVPResult"
+                },
+                "slope0": {
+                    "type": "number"
+                },
+                "slope1": {
+                    "type": "number"
+                },
+                "upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BVPNewtonStep": {
+            "type": "object",
+            "properties": {
+                "correction": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "residual": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BVPPoint": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "exact": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "yp": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BVPResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "h": {
+                    "type": "number"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "max_error": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "newton": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BVPNewtonStep"
+                    }
+                },
+                "shots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BVPShot"
+                    }
+                },
+                "slope": {
+                    "type": "number"
+                },
+                "table": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BVPPoint"
+                    }
+                }
+            }
+        },
+        "models.BVPShot": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "slope": {
+                    "type": "number"
+                },
+                "y_end": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BiCGSTAB": {
+            "type": "object",
+            "properties": {
+                "constant_data": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matrix_entries": {
+                    "type": "string"
+                },
+                "matrix_size": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.KrylovResult"
+                }
+            }
+        },
+        "models.Bisection": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Bivariate": {
+            "type": "object",
+            "properties": {
+                "grid": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "queries": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.BivariateResult"
+                }
+            }
+        },
+        "models.BivariateResult": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BivariateValue"
+                    }
+                }
+            }
+        },
+        "models.BivariateValue": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "value": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                },
+                "y_lower": {
+                    "type": "number"
+                },
+                "y_upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BracketedRootResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "function_evaluations": {
+                    "type": "integer"
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RootIteration"
+                    }
+                },
+                "root": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Brent": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.BracketedRootResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Chebyshev": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.ChebyshevResult"
+                },
+                "tolerance": {
+                    "type": "number"
+                },
+                "upper": {
+                    "type": "number"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ChebyshevResult": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "degree": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "number"
+                },
+                "kind": {
+                    "type": "integer"
+                },
+                "max_error": {
+                    "type": "number"
+                },
+                "max_error_at": {
+                    "type": "number"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "resolved": {
+                    "type": "boolean"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DerivativeEstimate": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "relative_error": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FFT": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.FFTResult"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "upper": {
+                    "type": "number"
+                },
+                "xvalues": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FFTBin": {
+            "type": "object",
+            "properties": {
+                "amplitude": {
+                    "type": "number"
+                },
+                "frequency": {
+                    "type": "number"
+                },
+                "imag": {
+                    "type": "number"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "magnitude": {
+                    "type": "number"
+                },
+                "phase": {
+                    "type": "number"
+                },
+                "real": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FFTResult": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "number"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "spectrum": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FFTBin"
+                    }
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FFTValue"
+                    }
+                }
+            }
+        },
+        "models.FFTValue": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "exact": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FalsePosition": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FloaterHormann": {
+            "type": "object",
+            "properties": {
+                "d": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.FloaterHormannResult"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FloaterHormannError": {
+            "type": "object",
+            "properties": {
+                "fx": {
+                    "type": "number"
+                },
+                "polynomial": {
+                    "type": "number"
+                },
+                "rational": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FloaterHormannResult": {
+            "type": "object",
+            "properties": {
+                "d": {
+                    "type": "integer"
+                },
+                "denominator": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FloaterHormannError"
+                    }
+                },
+                "max_error": {
+                    "type": "number"
+                },
+                "numerator": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "poles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PolynomialRoot"
+                    }
+                },
+                "polynomial_max_error": {
+                    "type": "number"
+                },
+                "polynomial_value": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.GMRES": {
+            "type": "object",
+            "properties": {
+                "constant_data": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matrix_entries": {
+                    "type": "string"
+                },
+                "matrix_size": {
+                    "type": "integer"
+                },
+                "restart": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.KrylovResult"
+                }
+            }
+        },
+        "models.GoldenSection": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.OptimizationResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.GradientDescent": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.OptimizationResult"
+                },
+                "step": {
+                    "type": "number"
+                },
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Graphical": {
+            "type": "object",
+            "properties": {
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "scan": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.HeatEquation": {
+            "type": "object",
+            "properties": {
+                "alpha": {
+                    "type": "number"
+                },
+                "dt": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "initial": {
+                    "type": "string"
+                },
+                "intervals": {
+                    "type": "integer"
+                },
+                "left": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PDEResult"
+                },
+                "right": {
+                    "type": "string"
+                },
+                "times": {
+                    "type": "string"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Hermite": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.HermiteResult"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.HermiteResult": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "method": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HermiteSegment"
+                    }
+                },
+                "slopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.HermiteSegment": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ITP": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.BracketedRootResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ImproperIntegral": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.ImproperIntegralResult"
+                },
+                "upper": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImproperIntegralResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "error_estimate": {
+                    "type": "number"
+                },
+                "evaluations": {
+                    "type": "integer"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImproperLevel"
+                    }
+                },
+                "method": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ImproperLevel": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IntervalIteration": {
+            "type": "object",
+            "properties": {
+                "f_lower": {
+                    "type": "number"
+                },
+                "f_upper": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "step": {
+                    "type": "string"
+                },
+                "upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IntervalNewton": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.IntervalRootResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IntervalRootResult": {
+            "type": "object",
+            "properties": {
+                "enclosures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RootEnclosure"
+                    }
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IntervalIteration"
+                    }
+                },
+                "no_root": {
+                    "type": "boolean"
+                },
+                "undecided": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RootEnclosure"
+                    }
+                }
+            }
+        },
+        "models.KrylovResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "relative_residual": {
+                    "type": "number"
+                },
+                "residual_history": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.LinearNewton": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "point": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.LinearRegression": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Matrix": {
+            "type": "object",
+            "properties": {
+                "constant_data": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matrix_data": {
+                    "type": "string"
+                },
+                "matrix_size": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                }
+            }
+        },
+        "models.MatrixAnalysis": {
+            "type": "object",
+            "properties": {
+                "matrix": {
+                    "$ref": "#/definitions/models.Matrix"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.MatrixAnalysisResult"
+                }
+            }
+        },
+        "models.MatrixAnalysisResult": {
+            "type": "object",
+            "properties": {
+                "backward_error": {
+                    "type": "number"
+                },
+                "forward_error_bound": {
+                    "type": "number"
+                },
+                "kappa_1": {
+                    "type": "number"
+                },
+                "kappa_inf": {
+                    "type": "number"
+                },
+                "refinement": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RefinementStep"
+                    }
+                },
+                "residual": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.MatrixIteration": {
+            "type": "object",
+            "properties": {
+                "constant_data": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matrix_data": {
+                    "type": "string"
+                },
+                "matrix_size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MonteCarlo": {
+            "type": "object",
+            "properties": {
+                "antithetic": {
+                    "type": "boolean"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.MonteCarloResult"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "strata": {
+                    "type": "integer"
+                },
+                "upper": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MonteCarloPoint": {
+            "type": "object",
+            "properties": {
+                "estimate": {
+                    "type": "number"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "standard_error": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.MonteCarloResult": {
+            "type": "object",
+            "properties": {
+                "convergence": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MonteCarloPoint"
+                    }
+                },
+                "dimension": {
+                    "type": "integer"
+                },
+                "estimate": {
+                    "type": "number"
+                },
+                "evaluations": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "replicates": {
+                    "type": "integer"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "standard_error": {
+                    "type": "number"
+                },
+                "strata": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MultipleIntegral": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.MultipleIntegralResult"
+                },
+                "x_interval": {
+                    "type": "integer"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                },
+                "y_interval": {
+                    "type": "integer"
+                },
+                "y_lower": {
+                    "type": "string"
+                },
+                "y_upper": {
+                    "type": "string"
+                },
+                "z_interval": {
+                    "type": "integer"
+                },
+                "z_lower": {
+                    "type": "string"
+                },
+                "z_upper": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MultipleIntegralResult": {
+            "type": "object",
+            "properties": {
+                "dimension": {
+                    "type": "integer"
+                },
+                "evaluations": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.MultipleRegression": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.NelderMead": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.OptimizationResult"
+                },
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.NewtonRaphson": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                },
+                "x0": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.NonlinearIteration": {
+            "type": "object",
+            "properties": {
+                "iteration": {
+                    "type": "integer"
+                },
+                "lambda": {
+                    "type": "number"
+                },
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "sum_squared_residuals": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.NonlinearRegression": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "initial": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "parameters": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.NonlinearRegressionResult"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.NonlinearRegressionResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "covariance": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NonlinearIteration"
+                    }
+                },
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegressionParameter"
+                    }
+                },
+                "prediction": {
+                    "type": "number"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "residuals": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "sum_squared_residuals": {
+                    "type": "number"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.NumericalDiff": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "h": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "Result is left out, and Error says why, when the stored input\ncannot be solved.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NumericalDiffResult"
+                        }
+                    ]
+                },
+                "x": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.NumericalDiffResult": {
+            "type": "object",
+            "properties": {
+                "derivative": {
+                    "type": "number"
+                },
+                "derivatives": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "estimates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DerivativeEstimate"
+                    }
+                },
+                "expression": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.OmegaSweep": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "omega": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.OnePoint": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                }
+            }
+        },
+        "models.OptimizationIteration": {
+            "type": "object",
+            "properties": {
+                "fx": {
+                    "type": "number"
+                },
+                "gradient_norm": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.OptimizationResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "function_evaluations": {
+                    "type": "integer"
+                },
+                "fx": {
+                    "type": "number"
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OptimizationIteration"
+                    }
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.PCG": {
+            "type": "object",
+            "properties": {
+                "matrix_iteration": {
+                    "$ref": "#/definitions/models.MatrixIteration"
+                },
+                "omega": {
+                    "type": "number"
+                },
+                "preconditioner": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PCGResult"
+                }
+            }
+        },
+        "models.PCGResult": {
+            "type": "object",
+            "properties": {
+                "preconditioner": {
+                    "type": "string"
+                },
+                "runs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PCGRun"
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.PCGRun": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "preconditioner": {
+                    "type": "string"
+                },
+                "relative_residual": {
+                    "type": "number"
+                },
+                "residual_history": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.PDEResult": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "r": {
+                    "type": "number"
+                },
+                "snapshots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PDESnapshot"
+                    }
+                },
+                "steps": {
+                    "type": "integer"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.PDESnapshot": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "type": "number"
+                },
+                "u": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.Pade": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "m": {
+                    "type": "integer"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PadeResult"
+                },
+                "x0": {
+                    "type": "number"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PadeResult": {
+            "type": "object",
+            "properties": {
+                "denominator": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "error": {
+                    "type": "number"
+                },
+                "exact": {
+                    "type": "number"
+                },
+                "numerator": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "poles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PolynomialRoot"
+                    }
+                },
+                "taylor": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "taylor_error": {
+                    "type": "number"
+                },
+                "taylor_value": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.ParabolicInterpolation": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.OptimizationResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Poisson": {
+            "type": "object",
+            "properties": {
+                "bottom": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "left": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "neumann": {
+                    "type": "string"
+                },
+                "omega": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PoissonResult"
+                },
+                "right": {
+                    "type": "string"
+                },
+                "top": {
+                    "type": "string"
+                },
+                "x_intervals": {
+                    "type": "integer"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                },
+                "y_intervals": {
+                    "type": "integer"
+                },
+                "y_lower": {
+                    "type": "number"
+                },
+                "y_upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PoissonIteration": {
+            "type": "object",
+            "properties": {
+                "iteration": {
+                    "type": "integer"
+                },
+                "relative_residual": {
+                    "type": "number"
+                },
+                "residual": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PoissonResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PoissonIteration"
+                    }
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "omega": {
+                    "type": "number"
+                },
+                "u": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "y": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.PolynomialNewton": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "point": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PolynomialRegression": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PolynomialRoot": {
+            "type": "object",
+            "properties": {
+                "imag": {
+                    "type": "number"
+                },
+                "multiplicity": {
+                    "type": "integer"
+                },
+                "real": {
+                    "type": "number"
+                },
+                "residual": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PolynomialRoots": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PolynomialRootsResult"
+                }
+            }
+        },
+        "models.PolynomialRootsResult": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "degree": {
+                    "type": "integer"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "roots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PolynomialRoot"
+                    }
+                }
+            }
+        },
+        "models.PrecisionResult": {
+            "type": "object",
+            "properties": {
+                "big_float": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "big_float_iterations": {
+                    "type": "integer"
+                },
+                "difference": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "float64": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "float64_iterations": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PrecisionStep"
+                    }
+                }
+            }
+        },
+        "models.PrecisionStep": {
+            "type": "object",
+            "properties": {
+                "big_float": {
+                    "type": "string"
+                },
+                "difference": {
+                    "type": "number"
+                },
+                "float64": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.QR": {
+            "type": "object",
+            "properties": {
+                "matrix": {
+                    "$ref": "#/definitions/models.Matrix"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.QRResult"
+                }
+            }
+        },
+        "models.QRFactorization": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string"
+                },
+                "orthogonality_loss": {
+                    "type": "number"
+                },
+                "q": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "r": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "residual": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.QRResult": {
+            "type": "object",
+            "properties": {
+                "factorizations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QRFactorization"
+                    }
+                }
+            }
+        },
+        "models.QuadraticLagrange": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "point": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.QuadraticNewton": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "point": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.QuadraticSpline": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "point": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RefinementStep": {
+            "type": "object",
+            "properties": {
+                "correction": {
+                    "type": "number"
+                },
+                "error": {
+                    "type": "number"
+                },
+                "residual": {
+                    "type": "number"
+                },
+                "step": {
+                    "type": "integer"
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.RegressionParameter": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "standard_error": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Ridders": {
+            "type": "object",
+            "properties": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.BracketedRootResult"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.RootEnclosure": {
+            "type": "object",
+            "properties": {
+                "lower": {
+                    "type": "number"
+                },
+                "upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.RootIteration": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "fx": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SOR": {
+            "type": "object",
+            "properties": {
+                "auto": {
+                    "type": "boolean"
+                },
+                "matrix_iteration": {
+                    "$ref": "#/definitions/models.MatrixIteration"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "omega": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.SORResult"
+                }
+            }
+        },
+        "models.SORIteration": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "number"
+                },
+                "iteration": {
+                    "type": "integer"
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.SORResult": {
+            "type": "object",
+            "properties": {
+                "converged": {
+                    "type": "boolean"
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SORIteration"
+                    }
+                },
+                "method": {
+                    "type": "string"
+                },
+                "omega": {
+                    "type": "number"
+                },
+                "optimal_omega": {
+                    "type": "number"
+                },
+                "spectral_radius": {
+                    "type": "number"
+                },
+                "sweep": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OmegaSweep"
+                    }
+                },
+                "x": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.Secant": {
+            "type": "object",
+            "properties": {
+                "X0": {
+                    "type": "number"
+                },
+                "X1": {
+                    "type": "number"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                }
+            }
+        },
+        "models.Simpson": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                },
+                "upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SmoothingSpline": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "lambda": {
+                    "type": "number"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.SplineFitResult"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SplineFitResult": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "degree": {
+                    "type": "integer"
+                },
+                "effective_degrees_of_freedom": {
+                    "type": "number"
+                },
+                "fitted": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "gcv": {
+                    "type": "number"
+                },
+                "knots": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "lambda": {
+                    "type": "number"
+                },
+                "prediction": {
+                    "type": "number"
+                },
+                "residuals": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "sum_squared_residuals": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SymbolicDerivative": {
+            "type": "object",
+            "properties": {
+                "expression": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SymbolicDiff": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.SymbolicDiffResult"
+                },
+                "x": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SymbolicDiffResult": {
+            "type": "object",
+            "properties": {
+                "derivatives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SymbolicDerivative"
+                    }
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.Trapezoid": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PrecisionResult"
+                },
+                "upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.WaveEquation": {
+            "type": "object",
+            "properties": {
+                "c": {
+                    "type": "number"
+                },
+                "dt": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "initial": {
+                    "type": "string"
+                },
+                "intervals": {
+                    "type": "integer"
+                },
+                "left": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.PDEResult"
+                },
+                "right": {
+                    "type": "string"
+                },
+                "times": {
+                    "type": "string"
+                },
+                "velocity": {
+                    "type": "string"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                }
+            }
+        },
+        "utils.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "validations.ReqBFGS": {
             "type": "object",
             "properties": {
                 "e": {
@@ -1628,18 +6993,93 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "id": {
+                "goal": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
+                }
+            }
+        },
+        "validations.ReqBSplineRegression": {
+            "type": "object",
+            "properties": {
+                "degree": {
                     "type": "integer"
                 },
-                "xl": {
+                "knots": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "segments": {
+                    "type": "integer"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "validations.ReqBVP": {
+            "type": "object",
+            "properties": {
+                "alpha": {
                     "type": "number"
                 },
-                "xr": {
+                "beta": {
+                    "type": "number"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "exact": {
+                    "type": "string"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "intervals": {
+                    "type": "integer"
+                },
+                "lower": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "slope0": {
+                    "type": "number"
+                },
+                "slope1": {
+                    "type": "number"
+                },
+                "upper": {
                     "type": "number"
                 }
             }
         },
-        "models.FalsePosition": {
+        "validations.ReqBiCGSTAB": {
+            "type": "object",
+            "properties": {
+                "constant_data": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "matrix_entries": {
+                    "type": "string"
+                },
+                "matrix_size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "validations.ReqBisection": {
             "type": "object",
             "properties": {
                 "e": {
@@ -1648,7 +7088,7 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "id": {
+                "precision": {
                     "type": "integer"
                 },
                 "xl": {
@@ -1659,69 +7099,104 @@ const docTemplate = `{
                 }
             }
         },
-        "models.Graphical": {
+        "validations.ReqBivariate": {
             "type": "object",
             "properties": {
-                "equation": {
+                "grid": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "method": {
+                    "type": "string"
                 },
-                "scan": {
-                    "type": "number"
+                "queries": {
+                    "type": "string"
                 }
             }
         },
-        "models.LinearNewton": {
+        "validations.ReqChebyshev": {
             "type": "object",
             "properties": {
-                "id": {
+                "function": {
+                    "type": "string"
+                },
+                "kind": {
                     "type": "integer"
                 },
-                "point": {
-                    "type": "string"
+                "lower": {
+                    "type": "number"
                 },
                 "points": {
-                    "type": "string"
+                    "type": "integer"
+                },
+                "tolerance": {
+                    "type": "number"
+                },
+                "upper": {
+                    "type": "number"
                 },
                 "xvalue": {
                     "type": "number"
                 }
             }
         },
-        "models.LinearRegression": {
+        "validations.ReqFFT": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "function": {
+                    "type": "string"
+                },
+                "lower": {
+                    "type": "number"
                 },
                 "points": {
                     "type": "string"
                 },
-                "xvalue": {
+                "samples": {
+                    "type": "integer"
+                },
+                "upper": {
                     "type": "number"
+                },
+                "xvalues": {
+                    "type": "string"
                 }
             }
         },
-        "models.Matrix": {
+        "validations.ReqFalsePosition": {
             "type": "object",
             "properties": {
-                "constant_data": {
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
                     "type": "string"
                 },
-                "id": {
+                "precision": {
                     "type": "integer"
                 },
-                "matrix_data": {
-                    "type": "string"
+                "xl": {
+                    "type": "number"
                 },
-                "matrix_size": {
+                "xr": {
+                    "type": "number"
+                }
+            }
+        },
+        "validations.ReqFloaterHormann": {
+            "type": "object",
+            "properties": {
+                "d": {
                     "type": "integer"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
                 }
             }
         },
-        "models.MatrixIteration": {
+        "validations.ReqGMRES": {
             "type": "object",
             "properties": {
                 "constant_data": {
@@ -1730,32 +7205,38 @@ const docTemplate = `{
                 "e": {
                     "type": "number"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "matrix_data": {
+                "matrix_entries": {
                     "type": "string"
                 },
                 "matrix_size": {
                     "type": "integer"
+                },
+                "restart": {
+                    "type": "integer"
                 }
             }
         },
-        "models.MultipleRegression": {
+        "validations.ReqGoldenSection": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "e": {
+                    "type": "number"
                 },
-                "points": {
+                "equation": {
                     "type": "string"
                 },
-                "xvalue": {
+                "goal": {
                     "type": "string"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
+                    "type": "number"
                 }
             }
         },
-        "models.NewtonRaphson": {
+        "validations.ReqGradientDescent": {
             "type": "object",
             "properties": {
                 "e": {
@@ -1764,55 +7245,70 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "goal": {
+                    "type": "string"
                 },
-                "x0": {
+                "step": {
                     "type": "number"
+                },
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
                 }
             }
         },
-        "models.NumericalDiff": {
+        "validations.ReqGraphical": {
             "type": "object",
             "properties": {
-                "function": {
+                "equation": {
                     "type": "string"
                 },
-                "h": {
+                "scan": {
                     "type": "number"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "order": {
-                    "type": "integer"
-                },
-                "x": {
-                    "type": "integer"
                 }
             }
         },
-        "models.OnePoint": {
+        "validations.ReqHeatEquation": {
             "type": "object",
             "properties": {
-                "e": {
+                "alpha": {
                     "type": "number"
                 },
-                "equation": {
+                "dt": {
+                    "type": "number"
+                },
+                "initial": {
                     "type": "string"
                 },
-                "id": {
+                "intervals": {
                     "type": "integer"
+                },
+                "left": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "string"
+                },
+                "times": {
+                    "type": "string"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
                 }
             }
         },
-        "models.PolynomialNewton": {
+        "validations.ReqHermite": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "point": {
+                "method": {
                     "type": "string"
                 },
                 "points": {
@@ -1823,29 +7319,29 @@ const docTemplate = `{
                 }
             }
         },
-        "models.PolynomialRegression": {
+        "validations.ReqImproperIntegral": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "e": {
+                    "type": "number"
                 },
-                "order": {
-                    "type": "integer"
+                "function": {
+                    "type": "string"
                 },
-                "points": {
+                "lower": {
                     "type": "string"
                 },
-                "xvalue": {
-                    "type": "number"
+                "method": {
+                    "type": "string"
+                },
+                "upper": {
+                    "type": "string"
                 }
             }
         },
-        "models.QuadraticLagrange": {
+        "validations.ReqLinearNewton": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
                 "point": {
                     "type": "string"
                 },
@@ -1857,15 +7353,9 @@ const docTemplate = `{
                 }
             }
         },
-        "models.QuadraticNewton": {
+        "validations.ReqLinearRegression": {
             "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "point": {
-                    "type": "string"
-                },
+            "properties": {
                 "points": {
                     "type": "string"
                 },
@@ -1874,93 +7364,119 @@ const docTemplate = `{
                 }
             }
         },
-        "models.QuadraticSpline": {
+        "validations.ReqMatrix": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "point": {
+                "constant_data": {
                     "type": "string"
                 },
-                "points": {
+                "matrix_data": {
                     "type": "string"
                 },
-                "xvalue": {
-                    "type": "string"
+                "matrix_size": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Secant": {
+        "validations.ReqMatrixIteration": {
             "type": "object",
             "properties": {
-                "X0": {
-                    "type": "number"
-                },
-                "X1": {
-                    "type": "number"
+                "constant_data": {
+                    "type": "string"
                 },
-                "e": {
+                "error": {
                     "type": "number"
                 },
-                "equation": {
+                "matrix_data": {
                     "type": "string"
                 },
-                "id": {
+                "matrix_size": {
                     "type": "integer"
                 }
             }
         },
-        "models.Simpson": {
+        "validations.ReqMonteCarlo": {
             "type": "object",
             "properties": {
+                "antithetic": {
+                    "type": "boolean"
+                },
                 "function": {
                     "type": "string"
                 },
-                "id": {
+                "lower": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "samples": {
                     "type": "integer"
                 },
-                "interval": {
+                "seed": {
                     "type": "integer"
                 },
-                "lower": {
-                    "type": "number"
+                "strata": {
+                    "type": "integer"
                 },
                 "upper": {
-                    "type": "number"
+                    "type": "string"
                 }
             }
         },
-        "models.Trapezoid": {
+        "validations.ReqMultipleIntegral": {
             "type": "object",
             "properties": {
                 "function": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "method": {
+                    "type": "string"
                 },
-                "interval": {
+                "x_interval": {
                     "type": "integer"
                 },
-                "lower": {
+                "x_lower": {
                     "type": "number"
                 },
-                "upper": {
+                "x_upper": {
                     "type": "number"
+                },
+                "y_interval": {
+                    "type": "integer"
+                },
+                "y_lower": {
+                    "type": "string"
+                },
+                "y_upper": {
+                    "type": "string"
+                },
+                "z_interval": {
+                    "type": "integer"
+                },
+                "z_lower": {
+                    "type": "string"
+                },
+                "z_upper": {
+                    "type": "string"
                 }
             }
         },
-        "utils.ErrorResponse": {
+        "validations.ReqMultipleRegression": {
             "type": "object",
             "properties": {
-                "error": {},
-                "message": {
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
                     "type": "string"
                 }
             }
         },
-        "validations.ReqBisection": {
+        "validations.ReqNelderMead": {
             "type": "object",
             "properties": {
                 "e": {
@@ -1969,15 +7485,18 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "xl": {
-                    "type": "number"
+                "goal": {
+                    "type": "string"
                 },
-                "xr": {
-                    "type": "number"
+                "variables": {
+                    "type": "string"
+                },
+                "x0": {
+                    "type": "string"
                 }
             }
         },
-        "validations.ReqFalsePosition": {
+        "validations.ReqNewtonRaphson": {
             "type": "object",
             "properties": {
                 "e": {
@@ -1986,42 +7505,29 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "xl": {
-                    "type": "number"
+                "precision": {
+                    "type": "integer"
                 },
-                "xr": {
+                "x0": {
                     "type": "number"
                 }
             }
         },
-        "validations.ReqGraphical": {
+        "validations.ReqNonlinearRegression": {
             "type": "object",
             "properties": {
-                "equation": {
+                "e": {
+                    "type": "number"
+                },
+                "initial": {
                     "type": "string"
                 },
-                "scan": {
-                    "type": "number"
-                }
-            }
-        },
-        "validations.ReqLinearNewton": {
-            "type": "object",
-            "properties": {
-                "point": {
+                "model": {
                     "type": "string"
                 },
-                "points": {
+                "parameters": {
                     "type": "string"
                 },
-                "xvalue": {
-                    "type": "number"
-                }
-            }
-        },
-        "validations.ReqLinearRegression": {
-            "type": "object",
-            "properties": {
                 "points": {
                     "type": "string"
                 },
@@ -2030,49 +7536,58 @@ const docTemplate = `{
                 }
             }
         },
-        "validations.ReqMatrix": {
+        "validations.ReqNumericalDiff": {
             "type": "object",
             "properties": {
-                "constant_data": {
+                "function": {
                     "type": "string"
                 },
-                "matrix_data": {
-                    "type": "string"
+                "h": {
+                    "type": "number"
                 },
-                "matrix_size": {
+                "order": {
+                    "type": "integer"
+                },
+                "x": {
                     "type": "integer"
                 }
             }
         },
-        "validations.ReqMatrixIteration": {
+        "validations.ReqOnePoint": {
             "type": "object",
             "properties": {
-                "constant_data": {
-                    "type": "string"
-                },
-                "error": {
+                "e": {
                     "type": "number"
                 },
-                "matrix_data": {
+                "equation": {
                     "type": "string"
                 },
-                "matrix_size": {
+                "precision": {
                     "type": "integer"
                 }
             }
         },
-        "validations.ReqMultipleRegression": {
+        "validations.ReqPade": {
             "type": "object",
             "properties": {
-                "points": {
+                "function": {
                     "type": "string"
                 },
+                "m": {
+                    "type": "integer"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "x0": {
+                    "type": "number"
+                },
                 "xvalue": {
-                    "type": "string"
+                    "type": "number"
                 }
             }
         },
-        "validations.ReqNewtonRaphson": {
+        "validations.ReqParabolicInterpolation": {
             "type": "object",
             "properties": {
                 "e": {
@@ -2081,36 +7596,64 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
-                "x0": {
+                "goal": {
+                    "type": "string"
+                },
+                "xl": {
+                    "type": "number"
+                },
+                "xr": {
                     "type": "number"
                 }
             }
         },
-        "validations.ReqNumericalDiff": {
+        "validations.ReqPoisson": {
             "type": "object",
             "properties": {
+                "bottom": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
                 "function": {
                     "type": "string"
                 },
-                "h": {
+                "left": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "neumann": {
+                    "type": "string"
+                },
+                "omega": {
                     "type": "number"
                 },
-                "order": {
+                "right": {
+                    "type": "string"
+                },
+                "top": {
+                    "type": "string"
+                },
+                "x_intervals": {
                     "type": "integer"
                 },
-                "x": {
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                },
+                "y_intervals": {
                     "type": "integer"
-                }
-            }
-        },
-        "validations.ReqOnePoint": {
-            "type": "object",
-            "properties": {
-                "e": {
+                },
+                "y_lower": {
                     "type": "number"
                 },
-                "equation": {
-                    "type": "string"
+                "y_upper": {
+                    "type": "number"
                 }
             }
         },
@@ -2142,6 +7685,20 @@ const docTemplate = `{
                 }
             }
         },
+        "validations.ReqPolynomialRoots": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "number"
+                },
+                "equation": {
+                    "type": "string"
+                }
+            }
+        },
         "validations.ReqQuadraticLagrange": {
             "type": "object",
             "properties": {
@@ -2193,6 +7750,9 @@ const docTemplate = `{
                 "equation": {
                     "type": "string"
                 },
+                "precision": {
+                    "type": "integer"
+                },
                 "x0": {
                     "type": "number"
                 },
@@ -2213,11 +7773,42 @@ const docTemplate = `{
                 "lower": {
                     "type": "number"
                 },
+                "precision": {
+                    "type": "integer"
+                },
                 "upper": {
                     "type": "number"
                 }
             }
         },
+        "validations.ReqSmoothingSpline": {
+            "type": "object",
+            "properties": {
+                "lambda": {
+                    "type": "number"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "xvalue": {
+                    "type": "number"
+                }
+            }
+        },
+        "validations.ReqSymbolicDiff": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "x": {
+                    "type": "number"
+                }
+            }
+        },
         "validations.ReqTrapezoid": {
             "type": "object",
             "properties": {
@@ -2230,10 +7821,48 @@ const docTemplate = `{
                 "lower": {
                     "type": "number"
                 },
+                "precision": {
+                    "type": "integer"
+                },
                 "upper": {
                     "type": "number"
                 }
             }
+        },
+        "validations.ReqWaveEquation": {
+            "type": "object",
+            "properties": {
+                "c": {
+                    "type": "number"
+                },
+                "dt": {
+                    "type": "number"
+                },
+                "initial": {
+                    "type": "string"
+                },
+                "intervals": {
+                    "type": "integer"
+                },
+                "left": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "string"
+                },
+                "times": {
+                    "type": "string"
+                },
+                "velocity": {
+                    "type": "string"
+                },
+                "x_lower": {
+                    "type": "number"
+                },
+                "x_upper": {
+                    "type": "number"
+                }
+            }
         }
     }
 }`
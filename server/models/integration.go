@@ -2,17 +2,124 @@ package models
 
 type (
 	Trapezoid struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Function string  `json:"function"`
-		Lower    float64 `json:"lower"`
-		Upper    float64 `json:"upper"`
-		Interval int     `json:"interval"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Function  string           `json:"function"`
+		Lower     float64          `json:"lower"`
+		Upper     float64          `json:"upper"`
+		Interval  int              `json:"interval"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
 	}
 	Simpson struct {
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Function  string           `json:"function"`
+		Lower     float64          `json:"lower"`
+		Upper     float64          `json:"upper"`
+		Interval  int              `json:"interval"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
+	}
+)
+
+type (
+	// MultipleIntegral is a double or triple iterated integral; the y limits
+	// may use x and the z limits may use x and y. The z limits are left
+	// empty for a double integral.
+	MultipleIntegral struct {
+		ID        uint    `json:"id" gorm:"autoIncrement"`
+		Function  string  `json:"function"`
+		Method    string  `json:"method"`
+		XLower    float64 `json:"x_lower"`
+		XUpper    float64 `json:"x_upper"`
+		YLower    string  `json:"y_lower"`
+		YUpper    string  `json:"y_upper"`
+		ZLower    string  `json:"z_lower"`
+		ZUpper    string  `json:"z_upper"`
+		XInterval int     `json:"x_interval"`
+		YInterval int     `json:"y_interval"`
+		ZInterval int     `json:"z_interval"`
+
+		Result MultipleIntegralResult `json:"result" gorm:"-"`
+	}
+
+	MultipleIntegralResult struct {
+		Dimension   int     `json:"dimension"`
+		Method      string  `json:"method"`
+		Value       float64 `json:"value"`
+		Evaluations int     `json:"evaluations"`
+	}
+)
+
+type (
+	// ImproperIntegral takes its bounds as text so that they can be -inf or
+	// inf as well as numbers.
+	ImproperIntegral struct {
 		ID       uint    `json:"id" gorm:"autoIncrement"`
 		Function string  `json:"function"`
-		Lower    float64 `json:"lower"`
-		Upper    float64 `json:"upper"`
-		Interval int     `json:"interval"`
+		Lower    string  `json:"lower"`
+		Upper    string  `json:"upper"`
+		Method   string  `json:"method"`
+		E        float64 `json:"e"`
+
+		Result ImproperIntegralResult `json:"result" gorm:"-"`
+	}
+
+	ImproperIntegralResult struct {
+		Method        string          `json:"method"`
+		Value         float64         `json:"value"`
+		ErrorEstimate float64         `json:"error_estimate"`
+		Converged     bool            `json:"converged"`
+		Evaluations   int             `json:"evaluations"`
+		Levels        []ImproperLevel `json:"levels"`
+	}
+
+	ImproperLevel struct {
+		Level int     `json:"level"`
+		Value float64 `json:"value"`
+		Error float64 `json:"error"`
+	}
+)
+
+type (
+	// MonteCarlo integrates Function over the box with corners Lower and
+	// Upper, comma separated lists with one value per dimension. The
+	// variables are x1, x2, ..., or x, y and z. Method is monte-carlo, sobol
+	// or halton; Strata > 1 splits each side into that many equal parts for
+	// stratified Monte Carlo, and Antithetic pairs every point u with 1 - u.
+	// Seed makes the random numbers, and the shifts of the quasi-random
+	// sequences, reproducible.
+	MonteCarlo struct {
+		ID         uint   `json:"id" gorm:"autoIncrement"`
+		Function   string `json:"function"`
+		Lower      string `json:"lower"`
+		Upper      string `json:"upper"`
+		Method     string `json:"method"`
+		Samples    int    `json:"samples"`
+		Antithetic bool   `json:"antithetic"`
+		Strata     int    `json:"strata"`
+		Seed       int64  `json:"seed"`
+
+		Result MonteCarloResult `json:"result" gorm:"-"`
+	}
+
+	// MonteCarloResult counts Samples per replicate for the quasi-random
+	// methods; Evaluations includes every replicate and antithetic point.
+	// Convergence holds the estimate after each power of two samples.
+	MonteCarloResult struct {
+		Method        string            `json:"method"`
+		Dimension     int               `json:"dimension"`
+		Samples       int               `json:"samples"`
+		Evaluations   int               `json:"evaluations"`
+		Replicates    int               `json:"replicates"`
+		Strata        int               `json:"strata"`
+		Estimate      float64           `json:"estimate"`
+		StandardError float64           `json:"standard_error"`
+		Convergence   []MonteCarloPoint `json:"convergence"`
+	}
+
+	MonteCarloPoint struct {
+		Samples       int     `json:"samples"`
+		Estimate      float64 `json:"estimate"`
+		StandardError float64 `json:"standard_error"`
 	}
 )
@@ -33,3 +33,210 @@ type (
 		Xvalue string `json:"xvalue"`
 	}
 )
+
+type (
+	// Chebyshev samples a function at Chebyshev nodes of the first or second
+	// kind. Points = 0 with a tolerance chooses the number of points itself.
+	Chebyshev struct {
+		ID        uint    `json:"id" gorm:"autoIncrement"`
+		Function  string  `json:"function"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		Kind      int     `json:"kind"`
+		Points    int     `json:"points"`
+		Tolerance float64 `json:"tolerance"`
+		Xvalue    float64 `json:"xvalue"`
+
+		Result ChebyshevResult `json:"result" gorm:"-"`
+	}
+
+	// ChebyshevResult holds the series sum c_k T_k(t) in t = (2x - a - b)/(b - a),
+	// truncated to Degree when a tolerance is given.
+	ChebyshevResult struct {
+		Kind         int       `json:"kind"`
+		Points       int       `json:"points"`
+		Nodes        []float64 `json:"nodes"`
+		Coefficients []float64 `json:"coefficients"`
+		Degree       int       `json:"degree"`
+		Resolved     bool      `json:"resolved"`
+		Value        float64   `json:"value"`
+		Error        float64   `json:"error"`
+		MaxError     float64   `json:"max_error"`
+		MaxErrorAt   float64   `json:"max_error_at"`
+	}
+)
+
+type (
+	// Pade is the [M/N] Padé approximant of a function about X0.
+	Pade struct {
+		ID       uint    `json:"id" gorm:"autoIncrement"`
+		Function string  `json:"function"`
+		X0       float64 `json:"x0"`
+		M        int     `json:"m"`
+		N        int     `json:"n"`
+		Xvalue   float64 `json:"xvalue"`
+
+		Result PadeResult `json:"result" gorm:"-"`
+	}
+
+	// PadeResult holds coefficients lowest degree first in powers of
+	// (x - x0). The Taylor polynomial uses as many coefficients as the
+	// approximant, so the two errors at xvalue compare like with like.
+	PadeResult struct {
+		Taylor      []float64        `json:"taylor"`
+		Numerator   []float64        `json:"numerator"`
+		Denominator []float64        `json:"denominator"`
+		Poles       []PolynomialRoot `json:"poles"`
+		Value       float64          `json:"value"`
+		Exact       float64          `json:"exact"`
+		Error       float64          `json:"error"`
+		TaylorValue float64          `json:"taylor_value"`
+		TaylorError float64          `json:"taylor_error"`
+	}
+
+	// FloaterHormann is the rational interpolant of blending degree D
+	// through the points.
+	FloaterHormann struct {
+		ID     uint    `json:"id" gorm:"autoIncrement"`
+		Points string  `json:"points"`
+		D      int     `json:"d"`
+		Xvalue float64 `json:"xvalue"`
+
+		Result FloaterHormannResult `json:"result" gorm:"-"`
+	}
+
+	// FloaterHormannResult holds the barycentric weights of the sorted
+	// points and the same interpolant as numerator/denominator coefficients
+	// in powers of x, lowest degree first. Errors are leave-one-out errors.
+	FloaterHormannResult struct {
+		D                  int                   `json:"d"`
+		Weights            []float64             `json:"weights"`
+		Numerator          []float64             `json:"numerator"`
+		Denominator        []float64             `json:"denominator"`
+		Poles              []PolynomialRoot      `json:"poles"`
+		Value              float64               `json:"value"`
+		PolynomialValue    float64               `json:"polynomial_value"`
+		Errors             []FloaterHormannError `json:"errors"`
+		MaxError           float64               `json:"max_error"`
+		PolynomialMaxError float64               `json:"polynomial_max_error"`
+	}
+
+	FloaterHormannError struct {
+		X          float64 `json:"x"`
+		Fx         float64 `json:"fx"`
+		Rational   float64 `json:"rational"`
+		Polynomial float64 `json:"polynomial"`
+	}
+)
+
+type (
+	// Bivariate interpolates a rectangular grid of z = f(x, y). Grid is CSV
+	// text: the first row lists the x values after a blank cell and each
+	// other row gives y followed by z at every x, e.g. ",0,1\n0,1,2\n1,3,4".
+	// Queries uses the points format, "x:0.5 y:0.5, x:0.2 y:0.8".
+	Bivariate struct {
+		ID      uint   `json:"id" gorm:"autoIncrement"`
+		Grid    string `json:"grid"`
+		Method  string `json:"method"`
+		Queries string `json:"queries"`
+
+		Result BivariateResult `json:"result" gorm:"-"`
+	}
+
+	BivariateResult struct {
+		Method string           `json:"method"`
+		Values []BivariateValue `json:"values"`
+	}
+
+	// BivariateValue is the interpolated value at (X, Y) and the patch
+	// sum Coefficients[i][j] u^i v^j of the cell holding it, where u and v
+	// run from 0 to 1 across the cell.
+	BivariateValue struct {
+		X            float64     `json:"x"`
+		Y            float64     `json:"y"`
+		Value        float64     `json:"value"`
+		XLower       float64     `json:"x_lower"`
+		XUpper       float64     `json:"x_upper"`
+		YLower       float64     `json:"y_lower"`
+		YUpper       float64     `json:"y_upper"`
+		Coefficients [][]float64 `json:"coefficients"`
+	}
+)
+
+type (
+	// Hermite interpolates points that may carry derivatives,
+	// "x:0 fx:1 dfx:0, x:1 fx:2 dfx:3", with the hermite or pchip method.
+	Hermite struct {
+		ID     uint    `json:"id" gorm:"autoIncrement"`
+		Points string  `json:"points"`
+		Method string  `json:"method"`
+		Xvalue float64 `json:"xvalue"`
+
+		Result HermiteResult `json:"result" gorm:"-"`
+	}
+
+	// HermiteResult holds, for the hermite method, the Newton form
+	// sum c_k (x - z_0) ... (x - z_{k-1}) with Nodes z, and for pchip the
+	// slopes at the sorted Nodes and the cubic pieces.
+	HermiteResult struct {
+		Method       string           `json:"method"`
+		Nodes        []float64        `json:"nodes"`
+		Coefficients []float64        `json:"coefficients,omitempty"`
+		Slopes       []float64        `json:"slopes,omitempty"`
+		Segments     []HermiteSegment `json:"segments,omitempty"`
+		Value        float64          `json:"value"`
+	}
+
+	// HermiteSegment is the cubic on [XLower, XUpper] with Coefficients in
+	// powers of (x - XLower), lowest degree first.
+	HermiteSegment struct {
+		XLower       float64   `json:"x_lower"`
+		XUpper       float64   `json:"x_upper"`
+		Coefficients []float64 `json:"coefficients"`
+	}
+)
+
+type (
+	// FFT transforms equally spaced Points, or Function sampled at Samples
+	// points of the period [Lower, Upper), and evaluates the trigonometric
+	// interpolant at the comma separated Xvalues.
+	FFT struct {
+		ID       uint    `json:"id" gorm:"autoIncrement"`
+		Points   string  `json:"points"`
+		Function string  `json:"function"`
+		Lower    float64 `json:"lower"`
+		Upper    float64 `json:"upper"`
+		Samples  int     `json:"samples"`
+		Xvalues  string  `json:"xvalues"`
+
+		Result FFTResult `json:"result" gorm:"-"`
+	}
+
+	FFTResult struct {
+		Method   string     `json:"method"`
+		Samples  int        `json:"samples"`
+		Period   float64    `json:"period"`
+		Spectrum []FFTBin   `json:"spectrum"`
+		Values   []FFTValue `json:"values"`
+	}
+
+	// FFTBin is the DFT coefficient X_k. Frequency is in cycles per unit of
+	// x, negative above n/2, and Amplitude is the size of the matching
+	// cosine wave in real data, which bins k and n - k both describe.
+	FFTBin struct {
+		Index     int     `json:"index"`
+		Frequency float64 `json:"frequency"`
+		Real      float64 `json:"real"`
+		Imag      float64 `json:"imag"`
+		Magnitude float64 `json:"magnitude"`
+		Phase     float64 `json:"phase"`
+		Amplitude float64 `json:"amplitude"`
+	}
+
+	FFTValue struct {
+		X     float64 `json:"x"`
+		Value float64 `json:"value"`
+		Exact float64 `json:"exact"`
+		Error float64 `json:"error"`
+	}
+)
@@ -17,4 +17,78 @@ type (
 		Points string `json:"points"`
 		Xvalue string `json:"xvalue"`
 	}
+	NonlinearRegression struct {
+		ID         uint                      `json:"id" gorm:"autoIncrement"`
+		Points     string                    `json:"points"`
+		Model      string                    `json:"model"`
+		Parameters string                    `json:"parameters"`
+		Initial    string                    `json:"initial"`
+		E          float64                   `json:"e"`
+		Xvalue     float64                   `json:"xvalue"`
+		Result     NonlinearRegressionResult `json:"result" gorm:"-"`
+	}
+
+	NonlinearRegressionResult struct {
+		Parameters          []RegressionParameter `json:"parameters"`
+		Covariance          [][]float64           `json:"covariance"`
+		Residuals           []float64             `json:"residuals"`
+		SumSquaredResiduals float64               `json:"sum_squared_residuals"`
+		Prediction          float64               `json:"prediction"`
+		Converged           bool                  `json:"converged"`
+		Reason              string                `json:"reason"`
+		Warnings            []string              `json:"warnings"`
+		Iterations          []NonlinearIteration  `json:"iterations"`
+	}
+	RegressionParameter struct {
+		Name          string   `json:"name"`
+		Value         float64  `json:"value"`
+		StandardError *float64 `json:"standard_error"`
+	}
+	NonlinearIteration struct {
+		Iteration           int       `json:"iteration"`
+		Parameters          []float64 `json:"parameters"`
+		SumSquaredResiduals float64   `json:"sum_squared_residuals"`
+		Lambda              float64   `json:"lambda"`
+	}
+)
+
+type (
+	// BSplineRegression fits a spline of Degree (0 means cubic) to the
+	// points by least squares. Knots lists the interior knots, e.g.
+	// "1, 2.5, 4"; without it Segments equal spans are used.
+	BSplineRegression struct {
+		ID       uint            `json:"id" gorm:"autoIncrement"`
+		Points   string          `json:"points"`
+		Degree   int             `json:"degree"`
+		Knots    string          `json:"knots"`
+		Segments int             `json:"segments"`
+		Xvalue   float64         `json:"xvalue"`
+		Result   SplineFitResult `json:"result" gorm:"-"`
+	}
+
+	// SmoothingSpline fits the cubic smoothing spline with penalty weight
+	// Lambda, or with the Lambda that minimizes GCV when it is 0.
+	SmoothingSpline struct {
+		ID     uint            `json:"id" gorm:"autoIncrement"`
+		Points string          `json:"points"`
+		Lambda float64         `json:"lambda"`
+		Xvalue float64         `json:"xvalue"`
+		Result SplineFitResult `json:"result" gorm:"-"`
+	}
+
+	// SplineFitResult gives the spline as sum c_i B_i(x) over the B-splines
+	// of Degree on the full Knots vector. Fitted values and residuals follow
+	// the order of the points.
+	SplineFitResult struct {
+		Degree                    int       `json:"degree"`
+		Knots                     []float64 `json:"knots"`
+		Coefficients              []float64 `json:"coefficients"`
+		Fitted                    []float64 `json:"fitted"`
+		Residuals                 []float64 `json:"residuals"`
+		SumSquaredResiduals       float64   `json:"sum_squared_residuals"`
+		EffectiveDegreesOfFreedom float64   `json:"effective_degrees_of_freedom"`
+		Lambda                    float64   `json:"lambda"`
+		GCV                       float64   `json:"gcv"`
+		Prediction                float64   `json:"prediction"`
+	}
 )
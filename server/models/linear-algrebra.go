@@ -2,10 +2,17 @@ package models
 
 type (
 	Matrix struct {
-		ID           uint   `json:"id" gorm:"autoIncrement"`
-		MatrixSize   int    `json:"matrix_size"`
-		MatrixData   string `json:"matrix_data"`
-		ConstantData string `json:"constant_data"`
+		ID           uint             `json:"id" gorm:"autoIncrement"`
+		MatrixSize   int              `json:"matrix_size"`
+		MatrixData   string           `json:"matrix_data"`
+		ConstantData string           `json:"constant_data"`
+		Precision    uint             `json:"precision"`
+		Result       *PrecisionResult `json:"result,omitempty" gorm:"-"`
+	}
+
+	MatrixAnalysis struct {
+		Matrix Matrix               `json:"matrix"`
+		Result MatrixAnalysisResult `json:"result"`
 	}
 
 	MatrixIteration struct {
@@ -15,4 +22,121 @@ type (
 		MatrixData   string  `json:"matrix_data"`
 		ConstantData string  `json:"constant_data"`
 	}
+
+	GMRES struct {
+		ID            uint         `json:"id" gorm:"autoIncrement"`
+		MatrixSize    int          `json:"matrix_size"`
+		MatrixEntries string       `json:"matrix_entries"`
+		ConstantData  string       `json:"constant_data"`
+		E             float64      `json:"e"`
+		Restart       int          `json:"restart"`
+		Result        KrylovResult `json:"result" gorm:"-"`
+	}
+
+	BiCGSTAB struct {
+		ID            uint         `json:"id" gorm:"autoIncrement"`
+		MatrixSize    int          `json:"matrix_size"`
+		MatrixEntries string       `json:"matrix_entries"`
+		ConstantData  string       `json:"constant_data"`
+		E             float64      `json:"e"`
+		Result        KrylovResult `json:"result" gorm:"-"`
+	}
+
+	PCG struct {
+		MatrixIteration MatrixIteration `json:"matrix_iteration"`
+		Preconditioner  string          `json:"preconditioner"`
+		Omega           float64         `json:"omega"`
+		Result          PCGResult       `json:"result"`
+	}
+
+	SOR struct {
+		MatrixIteration MatrixIteration `json:"matrix_iteration"`
+		Method          string          `json:"method"`
+		Omega           float64         `json:"omega"`
+		Auto            bool            `json:"auto"`
+		Result          SORResult       `json:"result"`
+	}
+
+	QR struct {
+		Matrix Matrix   `json:"matrix"`
+		Method string   `json:"method"`
+		Result QRResult `json:"result"`
+	}
+
+	KrylovResult struct {
+		X                []float64 `json:"x"`
+		Iterations       int       `json:"iterations"`
+		Converged        bool      `json:"converged"`
+		RelativeResidual float64   `json:"relative_residual"`
+		ResidualHistory  []float64 `json:"residual_history"`
+	}
+
+	PCGResult struct {
+		Preconditioner string    `json:"preconditioner"`
+		X              []float64 `json:"x"`
+		Runs           []PCGRun  `json:"runs"`
+	}
+
+	PCGRun struct {
+		Preconditioner   string    `json:"preconditioner"`
+		Iterations       int       `json:"iterations"`
+		Converged        bool      `json:"converged"`
+		RelativeResidual float64   `json:"relative_residual"`
+		ResidualHistory  []float64 `json:"residual_history"`
+		Error            string    `json:"error,omitempty"`
+	}
+
+	SORResult struct {
+		Method         string         `json:"method"`
+		Omega          float64        `json:"omega"`
+		SpectralRadius float64        `json:"spectral_radius"`
+		OptimalOmega   float64        `json:"optimal_omega"`
+		X              []float64      `json:"x"`
+		Converged      bool           `json:"converged"`
+		Iterations     []SORIteration `json:"iterations"`
+		Sweep          []OmegaSweep   `json:"sweep"`
+	}
+
+	SORIteration struct {
+		Iteration int       `json:"iteration"`
+		X         []float64 `json:"x"`
+		Error     float64   `json:"error"`
+	}
+
+	MatrixAnalysisResult struct {
+		X                 []float64        `json:"x"`
+		Kappa1            float64          `json:"kappa_1"`
+		KappaInf          float64          `json:"kappa_inf"`
+		Residual          float64          `json:"residual"`
+		BackwardError     float64          `json:"backward_error"`
+		ForwardErrorBound float64          `json:"forward_error_bound"`
+		Refinement        []RefinementStep `json:"refinement"`
+	}
+
+	RefinementStep struct {
+		Step       int       `json:"step"`
+		X          []float64 `json:"x"`
+		Residual   float64   `json:"residual"`
+		Correction float64   `json:"correction"`
+		Error      float64   `json:"error"`
+	}
+
+	QRResult struct {
+		Factorizations []QRFactorization `json:"factorizations"`
+	}
+
+	QRFactorization struct {
+		Method            string      `json:"method"`
+		Q                 [][]float64 `json:"q"`
+		R                 [][]float64 `json:"r"`
+		OrthogonalityLoss float64     `json:"orthogonality_loss"`
+		X                 []float64   `json:"x"`
+		Residual          float64     `json:"residual"`
+	}
+
+	OmegaSweep struct {
+		Omega      float64 `json:"omega"`
+		Iterations int     `json:"iterations"`
+		Converged  bool    `json:"converged"`
+	}
 )
@@ -7,5 +7,52 @@ type (
 		X        int     `json:"x"`
 		H        float32 `json:"h"`
 		Order    int     `json:"order"`
+
+		// Result is left out, and Error says why, when the stored input
+		// cannot be solved.
+		Result *NumericalDiffResult `json:"result,omitempty" gorm:"-"`
+		Error  string               `json:"error,omitempty" gorm:"-"`
+	}
+
+	// NumericalDiffResult holds f(x), f'(x), ..., f^(n)(x) from automatic
+	// differentiation and the approximations of f^(n)(x) compared with it.
+	// Expression is the symbolic f^(n), left out when it is too long.
+	NumericalDiffResult struct {
+		Method      string               `json:"method"`
+		Expression  string               `json:"expression,omitempty"`
+		Derivative  float64              `json:"derivative"`
+		Derivatives []float64            `json:"derivatives"`
+		Estimates   []DerivativeEstimate `json:"estimates"`
+	}
+
+	DerivativeEstimate struct {
+		Method        string  `json:"method"`
+		Value         float64 `json:"value"`
+		Error         float64 `json:"error"`
+		RelativeError float64 `json:"relative_error"`
+	}
+)
+
+type (
+	SymbolicDiff struct {
+		ID       uint    `json:"id" gorm:"autoIncrement"`
+		Function string  `json:"function"`
+		X        float64 `json:"x"`
+		Order    int     `json:"order"`
+
+		Result SymbolicDiffResult `json:"result" gorm:"-"`
+	}
+
+	// SymbolicDiffResult lists f and its derivatives up to the requested
+	// order, each as a simplified expression and its value at x.
+	SymbolicDiffResult struct {
+		Derivatives []SymbolicDerivative `json:"derivatives"`
+		Warnings    []string             `json:"warnings"`
+	}
+
+	SymbolicDerivative struct {
+		Order      int     `json:"order"`
+		Expression string  `json:"expression"`
+		Value      float64 `json:"value"`
 	}
 )
@@ -0,0 +1,63 @@
+package models
+
+type (
+	// BVP is y'' = Function on [Lower, Upper] with y(Lower) = Alpha and
+	// y(Upper) = Beta, where Function is an expression in x, y and yp = y'.
+	// Slope0 and Slope1 are the first two slopes y'(Lower) for shooting, and
+	// Exact is an optional solution in x to compare with.
+	BVP struct {
+		ID        uint      `json:"id" gorm:"autoIncrement"`
+		Function  string    `json:"function"`
+		Lower     float64   `json:"lower"`
+		Upper     float64   `json:"upper"`
+		Alpha     float64   `json:"alpha"`
+		Beta      float64   `json:"beta"`
+		Intervals int       `json:"intervals"`
+		Method    string    `json:"method"`
+		Slope0    float64   `json:"slope0"`
+		Slope1    float64   `json:"slope1"`
+		E         float64   `json:"e"`
+		Exact     string    `json:"exact"`
+		Result    BVPResult `json:"result" gorm:"-"`
+	}
+
+	// BVPResult holds the solution table and the log of the method: the
+	// secant iterations on the initial slope for shooting, or the Newton
+	// steps for finite differences. Slope is y'(Lower) of the solution.
+	BVPResult struct {
+		Method     string          `json:"method"`
+		H          float64         `json:"h"`
+		Iterations int             `json:"iterations"`
+		Converged  bool            `json:"converged"`
+		Slope      float64         `json:"slope"`
+		Table      []BVPPoint      `json:"table"`
+		MaxError   float64         `json:"max_error"`
+		Shots      []BVPShot       `json:"shots,omitempty"`
+		Newton     []BVPNewtonStep `json:"newton,omitempty"`
+	}
+
+	BVPPoint struct {
+		X     float64 `json:"x"`
+		Y     float64 `json:"y"`
+		YP    float64 `json:"yp"`
+		Exact float64 `json:"exact"`
+		Error float64 `json:"error"`
+	}
+
+	// BVPShot is one solution of the initial value problem, with Error =
+	// YEnd - Beta.
+	BVPShot struct {
+		Iteration int     `json:"iteration"`
+		Slope     float64 `json:"slope"`
+		YEnd      float64 `json:"y_end"`
+		Error     float64 `json:"error"`
+	}
+
+	// BVPNewtonStep holds the largest residual of the difference equations
+	// before the step and the largest correction made by it.
+	BVPNewtonStep struct {
+		Iteration  int     `json:"iteration"`
+		Residual   float64 `json:"residual"`
+		Correction float64 `json:"correction"`
+	}
+)
@@ -0,0 +1,70 @@
+package models
+
+type (
+	GoldenSection struct {
+		ID       uint               `json:"id" gorm:"autoIncrement"`
+		Equation string             `json:"equation"`
+		Xl       float64            `json:"xl"`
+		Xr       float64            `json:"xr"`
+		E        float64            `json:"e"`
+		Goal     string             `json:"goal"`
+		Result   OptimizationResult `json:"result" gorm:"-"`
+	}
+
+	ParabolicInterpolation struct {
+		ID       uint               `json:"id" gorm:"autoIncrement"`
+		Equation string             `json:"equation"`
+		Xl       float64            `json:"xl"`
+		Xr       float64            `json:"xr"`
+		E        float64            `json:"e"`
+		Goal     string             `json:"goal"`
+		Result   OptimizationResult `json:"result" gorm:"-"`
+	}
+
+	GradientDescent struct {
+		ID        uint               `json:"id" gorm:"autoIncrement"`
+		Equation  string             `json:"equation"`
+		Variables string             `json:"variables"`
+		X0        string             `json:"x0"`
+		Step      float64            `json:"step"`
+		E         float64            `json:"e"`
+		Goal      string             `json:"goal"`
+		Result    OptimizationResult `json:"result" gorm:"-"`
+	}
+
+	NelderMead struct {
+		ID        uint               `json:"id" gorm:"autoIncrement"`
+		Equation  string             `json:"equation"`
+		Variables string             `json:"variables"`
+		X0        string             `json:"x0"`
+		E         float64            `json:"e"`
+		Goal      string             `json:"goal"`
+		Result    OptimizationResult `json:"result" gorm:"-"`
+	}
+
+	BFGS struct {
+		ID        uint               `json:"id" gorm:"autoIncrement"`
+		Equation  string             `json:"equation"`
+		Variables string             `json:"variables"`
+		X0        string             `json:"x0"`
+		E         float64            `json:"e"`
+		Goal      string             `json:"goal"`
+		Result    OptimizationResult `json:"result" gorm:"-"`
+	}
+
+	OptimizationResult struct {
+		Variables           []string                `json:"variables"`
+		X                   []float64               `json:"x"`
+		Fx                  float64                 `json:"fx"`
+		Converged           bool                    `json:"converged"`
+		FunctionEvaluations int                     `json:"function_evaluations"`
+		Iterations          []OptimizationIteration `json:"iterations"`
+	}
+
+	OptimizationIteration struct {
+		Iteration    int       `json:"iteration"`
+		X            []float64 `json:"x"`
+		Fx           float64   `json:"fx"`
+		GradientNorm float64   `json:"gradient_norm"`
+	}
+)
@@ -0,0 +1,100 @@
+package models
+
+type (
+	// HeatEquation is u_t = Alpha u_xx on [XLower, XUpper] split into
+	// Intervals, with u(x, 0) = Initial and Dirichlet values Left and Right
+	// given as expressions in t. Times lists the snapshot times.
+	HeatEquation struct {
+		ID        uint      `json:"id" gorm:"autoIncrement"`
+		Initial   string    `json:"initial"`
+		Alpha     float64   `json:"alpha"`
+		XLower    float64   `json:"x_lower"`
+		XUpper    float64   `json:"x_upper"`
+		Left      string    `json:"left"`
+		Right     string    `json:"right"`
+		Intervals int       `json:"intervals"`
+		DT        float64   `json:"dt"`
+		Times     string    `json:"times"`
+		Method    string    `json:"method"`
+		Result    PDEResult `json:"result" gorm:"-"`
+	}
+
+	// WaveEquation is u_tt = C^2 u_xx with u(x, 0) = Initial and
+	// u_t(x, 0) = Velocity (0 when empty), on the same kind of grid.
+	WaveEquation struct {
+		ID        uint      `json:"id" gorm:"autoIncrement"`
+		Initial   string    `json:"initial"`
+		Velocity  string    `json:"velocity"`
+		C         float64   `json:"c"`
+		XLower    float64   `json:"x_lower"`
+		XUpper    float64   `json:"x_upper"`
+		Left      string    `json:"left"`
+		Right     string    `json:"right"`
+		Intervals int       `json:"intervals"`
+		DT        float64   `json:"dt"`
+		Times     string    `json:"times"`
+		Result    PDEResult `json:"result" gorm:"-"`
+	}
+
+	// PDEResult holds the grid X and the solution at each snapshot time,
+	// rounded to a whole number of steps. R is alpha dt / dx^2 for the heat
+	// equation and the Courant number c dt / dx for the wave equation.
+	PDEResult struct {
+		Method    string        `json:"method"`
+		R         float64       `json:"r"`
+		Steps     int           `json:"steps"`
+		Warnings  []string      `json:"warnings"`
+		X         []float64     `json:"x"`
+		Snapshots []PDESnapshot `json:"snapshots"`
+	}
+
+	PDESnapshot struct {
+		Time float64   `json:"time"`
+		U    []float64 `json:"u"`
+	}
+
+	// Poisson is u_xx + u_yy = Function on [XLower, XUpper] x [YLower,
+	// YUpper]. Left, Right, Bottom and Top are expressions in x and y (0
+	// when empty) giving the boundary value, or the outward normal
+	// derivative on the sides listed in Neumann, e.g. "left,top". Omega is
+	// for sor, 0 meaning the optimal value, and E is the factor the residual
+	// has to drop by.
+	Poisson struct {
+		ID         uint          `json:"id" gorm:"autoIncrement"`
+		Function   string        `json:"function"`
+		XLower     float64       `json:"x_lower"`
+		XUpper     float64       `json:"x_upper"`
+		YLower     float64       `json:"y_lower"`
+		YUpper     float64       `json:"y_upper"`
+		XIntervals int           `json:"x_intervals"`
+		YIntervals int           `json:"y_intervals"`
+		Left       string        `json:"left"`
+		Right      string        `json:"right"`
+		Bottom     string        `json:"bottom"`
+		Top        string        `json:"top"`
+		Neumann    string        `json:"neumann"`
+		Method     string        `json:"method"`
+		Omega      float64       `json:"omega"`
+		E          float64       `json:"e"`
+		Result     PoissonResult `json:"result" gorm:"-"`
+	}
+
+	// PoissonResult holds the solution U[j][i] at (X[i], Y[j]) and the
+	// residual after each iteration, or V-cycle for multigrid.
+	PoissonResult struct {
+		Method     string             `json:"method"`
+		Omega      float64            `json:"omega"`
+		Iterations int                `json:"iterations"`
+		Converged  bool               `json:"converged"`
+		X          []float64          `json:"x"`
+		Y          []float64          `json:"y"`
+		U          [][]float64        `json:"u"`
+		History    []PoissonIteration `json:"history"`
+	}
+
+	PoissonIteration struct {
+		Iteration        int     `json:"iteration"`
+		Residual         float64 `json:"residual"`
+		RelativeResidual float64 `json:"relative_residual"`
+	}
+)
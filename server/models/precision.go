@@ -0,0 +1,20 @@
+package models
+
+type (
+	PrecisionResult struct {
+		Precision          uint            `json:"precision"`
+		Float64            []float64       `json:"float64"`
+		BigFloat           []string        `json:"big_float"`
+		Difference         []float64       `json:"difference"`
+		Float64Iterations  int             `json:"float64_iterations,omitempty"`
+		BigFloatIterations int             `json:"big_float_iterations,omitempty"`
+		Steps              []PrecisionStep `json:"steps,omitempty"`
+	}
+
+	PrecisionStep struct {
+		Iteration  int     `json:"iteration"`
+		Float64    float64 `json:"float64"`
+		BigFloat   string  `json:"big_float"`
+		Difference float64 `json:"difference"`
+	}
+)
@@ -8,39 +8,147 @@ type (
 	}
 
 	Bisection struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Equation string  `json:"equation"`
-		Xl       float64 `json:"xl"`
-		Xr       float64 `json:"xr"`
-		E        float64 `json:"e"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Equation  string           `json:"equation"`
+		Xl        float64          `json:"xl"`
+		Xr        float64          `json:"xr"`
+		E         float64          `json:"e"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
 	}
 
 	FalsePosition struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Equation string  `json:"equation"`
-		Xl       float64 `json:"xl"`
-		Xr       float64 `json:"xr"`
-		E        float64 `json:"e"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Equation  string           `json:"equation"`
+		Xl        float64          `json:"xl"`
+		Xr        float64          `json:"xr"`
+		E         float64          `json:"e"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
 	}
 
 	OnePoint struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Equation string  `json:"equation"`
-		E        float64 `json:"e"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Equation  string           `json:"equation"`
+		E         float64          `json:"e"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
 	}
 
 	NewtonRaphson struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Equation string  `json:"equation"`
-		X0       float64 `json:"x0"`
-		E        float64 `json:"e"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Equation  string           `json:"equation"`
+		X0        float64          `json:"x0"`
+		E         float64          `json:"e"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
 	}
 
 	Secant struct {
-		ID       uint    `json:"id" gorm:"autoIncrement"`
-		Equation string  `json:""`
-		X0       float64 `json:"X0"`
-		X1       float64 `json:"X1"`
-		E        float64 `json:"e"`
+		ID        uint             `json:"id" gorm:"autoIncrement"`
+		Equation  string           `json:""`
+		X0        float64          `json:"X0"`
+		X1        float64          `json:"X1"`
+		E         float64          `json:"e"`
+		Precision uint             `json:"precision"`
+		Result    *PrecisionResult `json:"result,omitempty" gorm:"-"`
+	}
+
+	PolynomialRoots struct {
+		ID           uint                  `json:"id" gorm:"autoIncrement"`
+		Equation     string                `json:"equation"`
+		Coefficients string                `json:"coefficients"`
+		E            float64               `json:"e"`
+		Result       PolynomialRootsResult `json:"result" gorm:"-"`
+	}
+
+	PolynomialRootsResult struct {
+		Coefficients []float64        `json:"coefficients"`
+		Degree       int              `json:"degree"`
+		Iterations   int              `json:"iterations"`
+		Roots        []PolynomialRoot `json:"roots"`
+	}
+
+	PolynomialRoot struct {
+		Real         float64 `json:"real"`
+		Imag         float64 `json:"imag"`
+		Multiplicity int     `json:"multiplicity"`
+		Residual     float64 `json:"residual"`
+	}
+
+	Brent struct {
+		ID       uint                `json:"id" gorm:"autoIncrement"`
+		Equation string              `json:"equation"`
+		Xl       float64             `json:"xl"`
+		Xr       float64             `json:"xr"`
+		E        float64             `json:"e"`
+		Result   BracketedRootResult `json:"result" gorm:"-"`
+	}
+
+	Ridders struct {
+		ID       uint                `json:"id" gorm:"autoIncrement"`
+		Equation string              `json:"equation"`
+		Xl       float64             `json:"xl"`
+		Xr       float64             `json:"xr"`
+		E        float64             `json:"e"`
+		Result   BracketedRootResult `json:"result" gorm:"-"`
+	}
+
+	ITP struct {
+		ID       uint                `json:"id" gorm:"autoIncrement"`
+		Equation string              `json:"equation"`
+		Xl       float64             `json:"xl"`
+		Xr       float64             `json:"xr"`
+		E        float64             `json:"e"`
+		Result   BracketedRootResult `json:"result" gorm:"-"`
+	}
+
+	IntervalNewton struct {
+		ID       uint               `json:"id" gorm:"autoIncrement"`
+		Equation string             `json:"equation"`
+		Xl       float64            `json:"xl"`
+		Xr       float64            `json:"xr"`
+		E        float64            `json:"e"`
+		Result   IntervalRootResult `json:"result" gorm:"-"`
+	}
+
+	// IntervalRootResult lists the boxes proven to hold exactly one root and
+	// the boxes that could not be decided at the requested width. NoRoot is
+	// true when every part of [xl, xr] was proven to be root-free.
+	IntervalRootResult struct {
+		Enclosures []RootEnclosure     `json:"enclosures"`
+		Undecided  []RootEnclosure     `json:"undecided"`
+		NoRoot     bool                `json:"no_root"`
+		Iterations []IntervalIteration `json:"iterations"`
+	}
+
+	RootEnclosure struct {
+		Lower float64 `json:"lower"`
+		Upper float64 `json:"upper"`
+	}
+
+	IntervalIteration struct {
+		Iteration int     `json:"iteration"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		FLower    float64 `json:"f_lower"`
+		FUpper    float64 `json:"f_upper"`
+		Step      string  `json:"step"`
+	}
+
+	BracketedRootResult struct {
+		Root                float64         `json:"root"`
+		Converged           bool            `json:"converged"`
+		FunctionEvaluations int             `json:"function_evaluations"`
+		Iterations          []RootIteration `json:"iterations"`
+	}
+
+	RootIteration struct {
+		Iteration int     `json:"iteration"`
+		Xl        float64 `json:"xl"`
+		Xr        float64 `json:"xr"`
+		X         float64 `json:"x"`
+		Fx        float64 `json:"fx"`
+		Error     float64 `json:"error"`
 	}
 )
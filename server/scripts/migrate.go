@@ -30,6 +30,35 @@ func ScriptMigrate() {
 		&models.Trapezoid{},
 		&models.Simpson{},
 		&models.QuadraticSpline{},
+		&models.PolynomialRoots{},
+		&models.Brent{},
+		&models.Ridders{},
+		&models.ITP{},
+		&models.NonlinearRegression{},
+		&models.GMRES{},
+		&models.BiCGSTAB{},
+		&models.IntervalNewton{},
+		&models.SymbolicDiff{},
+		&models.MultipleIntegral{},
+		&models.ImproperIntegral{},
+		&models.Chebyshev{},
+		&models.Pade{},
+		&models.FloaterHormann{},
+		&models.Bivariate{},
+		&models.Hermite{},
+		&models.BSplineRegression{},
+		&models.SmoothingSpline{},
+		&models.FFT{},
+		&models.GoldenSection{},
+		&models.ParabolicInterpolation{},
+		&models.GradientDescent{},
+		&models.NelderMead{},
+		&models.BFGS{},
+		&models.HeatEquation{},
+		&models.WaveEquation{},
+		&models.Poisson{},
+		&models.BVP{},
+		&models.MonteCarlo{},
 	); err != nil {
 		log.Fatalf("failed to migrate database: %v", err)
 	}
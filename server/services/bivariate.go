@@ -0,0 +1,221 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	bivariateMaxGrid    = 500
+	bivariateMaxQueries = 1000
+)
+
+// bicubicMatrix turns the values and derivatives at the corners of a cell
+// into the coefficients of the cubic on [0, 1] in each direction.
+var bicubicMatrix = [4][4]float64{
+	{1, 0, 0, 0},
+	{0, 0, 1, 0},
+	{-3, 3, -2, -1},
+	{2, -2, 1, 1},
+}
+
+// parseGrid reads a grid whose first row is a blank or label cell followed
+// by the x values and whose other rows are a y value followed by
+// z = f(x, y) at each x. Rows are separated by new lines or semicolons and
+// cells by commas.
+func parseGrid(input string) ([]float64, []float64, [][]float64, error) {
+	var rows [][]float64
+	for _, line := range strings.FieldsFunc(input, func(r rune) bool { return r == '\n' || r == ';' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		cells := strings.Split(line, ",")
+		row := make([]float64, len(cells))
+		for j, cell := range cells {
+			if len(rows) == 0 && j == 0 {
+				continue
+			}
+			cell = strings.TrimSpace(cell)
+			value, err := strconv.ParseFloat(cell, 64)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("row %d: invalid number %q", len(rows)+1, cell)
+			}
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, nil, nil, fmt.Errorf("row %d: %q is not finite", len(rows)+1, cell)
+			}
+			row[j] = value
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) < 3 || len(rows[0]) < 3 {
+		return nil, nil, nil, fmt.Errorf("grid must have at least 2 x values and 2 y values")
+	}
+	xs := rows[0][1:]
+	ys := make([]float64, len(rows)-1)
+	zs := make([][]float64, len(rows)-1)
+	for i, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			return nil, nil, nil, fmt.Errorf("row %d must have %d cells", i+2, len(rows[0]))
+		}
+		ys[i], zs[i] = row[0], row[1:]
+	}
+	if len(xs) > bivariateMaxGrid || len(ys) > bivariateMaxGrid {
+		return nil, nil, nil, fmt.Errorf("grid must have at most %d x values and %d y values", bivariateMaxGrid, bivariateMaxGrid)
+	}
+	for name, values := range map[string][]float64{"x": xs, "y": ys} {
+		for i := 1; i < len(values); i++ {
+			if values[i] <= values[i-1] {
+				return nil, nil, nil, fmt.Errorf("%s values must be strictly increasing", name)
+			}
+		}
+	}
+	return xs, ys, zs, nil
+}
+
+// gridSlopes estimates df/dt at every node of t from the values along one
+// line of the grid: the three point formula for uneven spacing inside, and
+// one sided differences at the ends.
+func gridSlopes(t, f []float64) []float64 {
+	n := len(t)
+	slopes := make([]float64, n)
+	slopes[0] = (f[1] - f[0]) / (t[1] - t[0])
+	slopes[n-1] = (f[n-1] - f[n-2]) / (t[n-1] - t[n-2])
+	for i := 1; i < n-1; i++ {
+		h0, h1 := t[i]-t[i-1], t[i+1]-t[i]
+		slopes[i] = (h0*h0*f[i+1] - h1*h1*f[i-1] + (h1*h1-h0*h0)*f[i]) / (h0 * h1 * (h0 + h1))
+	}
+	return slopes
+}
+
+// gridCell returns the index of the cell [t_i, t_i+1] holding value.
+func gridCell(t []float64, value float64) int {
+	i := 0
+	for i < len(t)-2 && value > t[i+1] {
+		i++
+	}
+	return i
+}
+
+// solveBivariate interpolates z = f(x, y) from a rectangular grid at each
+// query point. Each answer carries the coefficients a[i][j] of its patch
+// sum a[i][j] u^i v^j, with u = (x - x0)/(x1 - x0) and v = (y - y0)/(y1 - y0)
+// local to the cell. Bicubic patches match f and the finite difference
+// estimates of f_x, f_y and f_xy at the cell corners, so they join with
+// continuous first derivatives.
+func solveBivariate(p models.Bivariate) (models.BivariateResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "bilinear"
+	}
+	if method != "bilinear" && method != "bicubic" {
+		return models.BivariateResult{}, fmt.Errorf("method must be bilinear or bicubic")
+	}
+	xs, ys, zs, err := parseGrid(p.Grid)
+	if err != nil {
+		return models.BivariateResult{}, fmt.Errorf("invalid grid: %v", err)
+	}
+	queries, err := utils.ParsePointFields(p.Queries)
+	if err != nil {
+		return models.BivariateResult{}, fmt.Errorf("invalid queries: %v", err)
+	}
+	if len(queries) > bivariateMaxQueries {
+		return models.BivariateResult{}, fmt.Errorf("queries must have at most %d points", bivariateMaxQueries)
+	}
+
+	// zx[j][i], zy[j][i] and zxy[j][i] are the derivatives at (x_i, y_j).
+	var zx, zy, zxy [][]float64
+	if method == "bicubic" {
+		zx = make([][]float64, len(ys))
+		for j := range ys {
+			zx[j] = gridSlopes(xs, zs[j])
+		}
+		zy, zxy = make([][]float64, len(ys)), make([][]float64, len(ys))
+		for j := range ys {
+			zy[j], zxy[j] = make([]float64, len(xs)), make([]float64, len(xs))
+		}
+		column, columnX := make([]float64, len(ys)), make([]float64, len(ys))
+		for i := range xs {
+			for j := range ys {
+				column[j], columnX[j] = zs[j][i], zx[j][i]
+			}
+			slopes, crossSlopes := gridSlopes(ys, column), gridSlopes(ys, columnX)
+			for j := range ys {
+				zy[j][i], zxy[j][i] = slopes[j], crossSlopes[j]
+			}
+		}
+	}
+
+	result := models.BivariateResult{Method: method}
+	for k, query := range queries {
+		x, hasX := query["x"]
+		y, hasY := query["y"]
+		if !hasX || !hasY {
+			return models.BivariateResult{}, fmt.Errorf("query %d must have x and y", k+1)
+		}
+		if math.IsNaN(x) || math.IsInf(x, 0) || math.IsNaN(y) || math.IsInf(y, 0) {
+			return models.BivariateResult{}, fmt.Errorf("query %d (%v, %v) is not finite", k+1, x, y)
+		}
+		if x < xs[0] || x > xs[len(xs)-1] || y < ys[0] || y > ys[len(ys)-1] {
+			return models.BivariateResult{}, fmt.Errorf("query %d (%v, %v) is outside the grid", k+1, x, y)
+		}
+		i, j := gridCell(xs, x), gridCell(ys, y)
+		hx, hy := xs[i+1]-xs[i], ys[j+1]-ys[j]
+		u, v := (x-xs[i])/hx, (y-ys[j])/hy
+
+		var coefficients [][]float64
+		if method == "bilinear" {
+			z00, z10, z01, z11 := zs[j][i], zs[j][i+1], zs[j+1][i], zs[j+1][i+1]
+			coefficients = [][]float64{
+				{z00, z01 - z00},
+				{z10 - z00, z11 - z10 - z01 + z00},
+			}
+		} else {
+			// Corner data F[a][b] for f, f_v, f_u and f_uv at (u, v) = (a, b),
+			// laid out so that the coefficients are M F M^T.
+			var F [4][4]float64
+			for a := 0; a < 2; a++ {
+				for b := 0; b < 2; b++ {
+					F[a][b] = zs[j+b][i+a]
+					F[a][b+2] = zy[j+b][i+a] * hy
+					F[a+2][b] = zx[j+b][i+a] * hx
+					F[a+2][b+2] = zxy[j+b][i+a] * hx * hy
+				}
+			}
+			coefficients = make([][]float64, 4)
+			for r := range coefficients {
+				coefficients[r] = make([]float64, 4)
+				for c := range coefficients[r] {
+					for a := 0; a < 4; a++ {
+						for b := 0; b < 4; b++ {
+							coefficients[r][c] += bicubicMatrix[r][a] * F[a][b] * bicubicMatrix[c][b]
+						}
+					}
+				}
+			}
+		}
+
+		value := 0.0
+		for a := range coefficients {
+			for b := range coefficients[a] {
+				value += coefficients[a][b] * math.Pow(u, float64(a)) * math.Pow(v, float64(b))
+			}
+		}
+		result.Values = append(result.Values, models.BivariateValue{
+			X:            x,
+			Y:            y,
+			Value:        value,
+			XLower:       xs[i],
+			XUpper:       xs[i+1],
+			YLower:       ys[j],
+			YUpper:       ys[j+1],
+			Coefficients: coefficients,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+// bracketMaxIterations caps the hybrid bracketed methods. E is the relative
+// approximate error in percent, the same stopping rule as the bisection page.
+const bracketMaxIterations = 500
+
+// countedFunction wraps f, records how many times it was evaluated and
+// remembers the first point where it was not finite.
+type countedFunction struct {
+	f           func(float64) float64
+	evaluations int
+	undefined   bool
+	undefinedAt float64
+}
+
+func (c *countedFunction) eval(x float64) float64 {
+	c.evaluations++
+	y := c.f(x)
+	if !c.undefined && (math.IsNaN(y) || math.IsInf(y, 0)) {
+		c.undefined = true
+		c.undefinedAt = x
+	}
+	return y
+}
+
+// err reports an evaluation outside the domain of the equation.
+func (c *countedFunction) err() error {
+	if c.undefined {
+		return fmt.Errorf("equation is not defined at x = %g", c.undefinedAt)
+	}
+	return nil
+}
+
+// newBracket parses the equation and checks that [xl, xr] brackets a root.
+func newBracket(equation string, xl, xr, e float64) (*countedFunction, float64, float64, error) {
+	f, err := utils.ParseFunction(equation, "x")
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("invalid equation: %v", err)
+	}
+	if e <= 0 {
+		return nil, 0, 0, fmt.Errorf("e must be greater than 0")
+	}
+	if xl == xr {
+		return nil, 0, 0, fmt.Errorf("xl and xr must be different")
+	}
+	counted := &countedFunction{f: f}
+	fl, fr := counted.eval(xl), counted.eval(xr)
+	if err := counted.err(); err != nil {
+		return nil, 0, 0, err
+	}
+	if fl*fr > 0 {
+		return nil, 0, 0, fmt.Errorf("f(xl) and f(xr) must have opposite signs")
+	}
+	return counted, fl, fr, nil
+}
+
+// relativeError is the approximate error in percent used by the client pages.
+func relativeError(xOld, xNew float64) float64 {
+	if xNew == 0 {
+		return math.Abs(xNew - xOld)
+	}
+	return math.Abs((xNew-xOld)/xNew) * 100
+}
+
+// sortedBracket returns the interval end points in increasing order.
+func sortedBracket(a, b float64) (float64, float64) {
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
+
+// solveBrent combines inverse quadratic interpolation, the secant step and
+// bisection, falling back to bisection whenever interpolation is not trusted.
+func solveBrent(p models.Brent) (models.BracketedRootResult, error) {
+	f, fa, fb, err := newBracket(p.Equation, p.Xl, p.Xr, p.E)
+	if err != nil {
+		return models.BracketedRootResult{}, err
+	}
+
+	a, b := p.Xl, p.Xr
+	c, fc := b, fb
+	var d, e float64
+	result := models.BracketedRootResult{}
+
+	for i := 1; i <= bracketMaxIterations; i++ {
+		if (fb > 0 && fc > 0) || (fb < 0 && fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*machineEpsilon*math.Abs(b) + 0.5*p.E/100*math.Abs(b)
+		xm := 0.5 * (c - b)
+		if math.Abs(xm) <= tol || fb == 0 {
+			result.Converged = true
+			break
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var pp, q float64
+			s := fb / fa
+			if a == c {
+				// Secant step.
+				pp = 2 * xm * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				q = fa / fc
+				r := fb / fc
+				pp = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if pp > 0 {
+				q = -q
+			}
+			pp = math.Abs(pp)
+			if 2*pp < math.Min(3*xm*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = pp / q
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			d = xm
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, xm)
+		}
+		fb = f.eval(b)
+
+		xl, xr := sortedBracket(b, c)
+		result.Iterations = append(result.Iterations, models.RootIteration{
+			Iteration: i,
+			Xl:        xl,
+			Xr:        xr,
+			X:         b,
+			Fx:        fb,
+			Error:     relativeError(a, b),
+		})
+		if relativeError(a, b) <= p.E || fb == 0 {
+			result.Converged = true
+			break
+		}
+	}
+
+	if err := f.err(); err != nil {
+		return models.BracketedRootResult{}, err
+	}
+	result.Root = b
+	result.FunctionEvaluations = f.evaluations
+	return result, nil
+}
+
+// solveRidders fits an exponential through the bracket and its midpoint so
+// that the false position step lands on a straight line.
+func solveRidders(p models.Ridders) (models.BracketedRootResult, error) {
+	f, fl, fr, err := newBracket(p.Equation, p.Xl, p.Xr, p.E)
+	if err != nil {
+		return models.BracketedRootResult{}, err
+	}
+
+	xl, xr := p.Xl, p.Xr
+	result := models.BracketedRootResult{}
+	x := xl
+	if fr == 0 {
+		x = xr
+	}
+	if fl == 0 || fr == 0 {
+		result.Root = x
+		result.Converged = true
+		result.FunctionEvaluations = f.evaluations
+		return result, nil
+	}
+
+	for i := 1; i <= bracketMaxIterations; i++ {
+		xOld := x
+		xm := 0.5 * (xl + xr)
+		fm := f.eval(xm)
+		s := math.Sqrt(fm*fm - fl*fr)
+		if s == 0 {
+			// fm vanished, so the midpoint is the root.
+			x = xm
+			ea := 0.0
+			if i > 1 {
+				ea = relativeError(xOld, x)
+			}
+			lo, hi := sortedBracket(xl, xr)
+			result.Iterations = append(result.Iterations, models.RootIteration{
+				Iteration: i,
+				Xl:        lo,
+				Xr:        hi,
+				X:         x,
+				Fx:        fm,
+				Error:     ea,
+			})
+			result.Converged = true
+			break
+		}
+		x = xm + (xm-xl)*math.Copysign(1, fl-fr)*fm/s
+		fx := f.eval(x)
+
+		switch {
+		case fx == 0:
+		case math.Signbit(fm) != math.Signbit(fx):
+			xl, fl = xm, fm
+			xr, fr = x, fx
+		case math.Signbit(fl) != math.Signbit(fx):
+			xr, fr = x, fx
+		default:
+			xl, fl = x, fx
+		}
+
+		// The first step is measured from the midpoint it started from.
+		ea := relativeError(xm, x)
+		if i > 1 {
+			ea = relativeError(xOld, x)
+		}
+		lo, hi := sortedBracket(xl, xr)
+		result.Iterations = append(result.Iterations, models.RootIteration{
+			Iteration: i,
+			Xl:        lo,
+			Xr:        hi,
+			X:         x,
+			Fx:        fx,
+			Error:     ea,
+		})
+		if fx == 0 || (i > 1 && ea <= p.E) {
+			result.Converged = true
+			break
+		}
+	}
+
+	if err := f.err(); err != nil {
+		return models.BracketedRootResult{}, err
+	}
+	result.Root = x
+	result.FunctionEvaluations = f.evaluations
+	return result, nil
+}
+
+// solveITP runs the Interpolate-Truncate-Project method, which keeps the
+// worst case of bisection while converging superlinearly on smooth functions.
+func solveITP(p models.ITP) (models.BracketedRootResult, error) {
+	f, ya, yb, err := newBracket(p.Equation, p.Xl, p.Xr, p.E)
+	if err != nil {
+		return models.BracketedRootResult{}, err
+	}
+
+	a, b := sortedBracket(p.Xl, p.Xr)
+	if a != p.Xl {
+		ya, yb = yb, ya
+	}
+	result := models.BracketedRootResult{}
+	if ya == 0 || yb == 0 {
+		result.Root = a
+		if yb == 0 {
+			result.Root = b
+		}
+		result.Converged = true
+		result.FunctionEvaluations = f.evaluations
+		return result, nil
+	}
+
+	// The absolute half-width target that matches the percent tolerance.
+	epsilon := 0.5 * p.E / 100 * math.Max(math.Abs(a), math.Abs(b))
+	epsilon = math.Max(epsilon, 4*machineEpsilon*math.Max(math.Abs(a), math.Abs(b)))
+	if epsilon == 0 {
+		epsilon = p.E / 100
+	}
+	const (
+		kappa2 = 2.0
+		n0     = 1
+	)
+	kappa1 := 0.2 / (b - a)
+	nHalf := int(math.Ceil(math.Log2((b - a) / (2 * epsilon))))
+	nMax := nHalf + n0
+
+	// x starts at the midpoint, so the first error is measured from there.
+	x := 0.5 * (a + b)
+	for j := 0; b-a > 2*epsilon && j < bracketMaxIterations; j++ {
+		xOld := x
+		// Interpolation: regula falsi point.
+		xHalf := 0.5 * (a + b)
+		r := epsilon*math.Pow(2, float64(nMax-j)) - 0.5*(b-a)
+		delta := kappa1 * math.Pow(b-a, kappa2)
+		xf := (yb*a - ya*b) / (yb - ya)
+
+		// Truncation: perturb towards the midpoint.
+		sigma := math.Copysign(1, xHalf-xf)
+		xt := xHalf
+		if delta <= math.Abs(xHalf-xf) {
+			xt = xf + sigma*delta
+		}
+
+		// Projection: stay inside the minmax disk around the midpoint.
+		x = xHalf - sigma*r
+		if math.Abs(xt-xHalf) <= r {
+			x = xt
+		}
+
+		y := f.eval(x)
+		switch {
+		case y == 0:
+			a, b = x, x
+		case math.Signbit(y) == math.Signbit(yb):
+			b, yb = x, y
+		default:
+			a, ya = x, y
+		}
+
+		result.Iterations = append(result.Iterations, models.RootIteration{
+			Iteration: j + 1,
+			Xl:        a,
+			Xr:        b,
+			X:         x,
+			Fx:        y,
+			Error:     relativeError(xOld, x),
+		})
+	}
+
+	if err := f.err(); err != nil {
+		return models.BracketedRootResult{}, err
+	}
+	if a != b {
+		x = 0.5 * (a + b)
+	}
+	result.Root = x
+	result.Converged = b-a <= 2*epsilon
+	result.FunctionEvaluations = f.evaluations
+	return result, nil
+}
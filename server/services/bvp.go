@@ -0,0 +1,206 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	bvpMaxIntervals  = 10000
+	bvpMaxIterations = 100
+)
+
+// shoot integrates d^2y/dx^2 = f(x, y, y') from y(a) = alpha and
+// y'(a) = slope with the classical fourth order Runge–Kutta method over the
+// grid xs and returns y and y' at every point.
+func shoot(f func(x, y, yp float64) float64, xs []float64, alpha, slope float64) ([]float64, []float64) {
+	y, yp := make([]float64, len(xs)), make([]float64, len(xs))
+	y[0], yp[0] = alpha, slope
+	for i := 0; i < len(xs)-1; i++ {
+		x, h := xs[i], xs[i+1]-xs[i]
+		k1, l1 := yp[i], f(x, y[i], yp[i])
+		k2, l2 := yp[i]+h/2*l1, f(x+h/2, y[i]+h/2*k1, yp[i]+h/2*l1)
+		k3, l3 := yp[i]+h/2*l2, f(x+h/2, y[i]+h/2*k2, yp[i]+h/2*l2)
+		k4, l4 := yp[i]+h*l3, f(x+h, y[i]+h*k3, yp[i]+h*l3)
+		y[i+1] = y[i] + h/6*(k1+2*k2+2*k3+k4)
+		yp[i+1] = yp[i] + h/6*(l1+2*l2+2*l3+l4)
+	}
+	return y, yp
+}
+
+// solveBVP solves d^2y/dx^2 = f(x, y, yp) with y(a) = alpha and
+// y(b) = beta on a grid of equal intervals. The shooting method integrates the initial
+// value problem with y'(a) = t and adjusts t by the secant method until
+// y(b; t) is within e of beta, starting from slope0 and slope1, or from
+// the slope of the line through the boundary values and one more when both
+// are 0. The finite-difference method replaces the derivatives with
+// central differences,
+//
+//	(w_{i-1} - 2 w_i + w_{i+1}) / h^2 = f(x_i, w_i, (w_{i+1} - w_{i-1}) / (2h)),
+//
+// and solves the equations by Newton's method, each step being a
+// tridiagonal system, until the largest correction is at most e. A linear
+// equation is solved by the first step, and the second confirms it.
+func solveBVP(p models.BVP) (models.BVPResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "shooting"
+	}
+	if method != "shooting" && method != "finite-difference" {
+		return models.BVPResult{}, fmt.Errorf("method must be shooting or finite-difference")
+	}
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.BVPResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x", "y", "yp"); err != nil {
+		return models.BVPResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	var exact func(float64) float64
+	if strings.TrimSpace(p.Exact) != "" {
+		exactExpr, err := utils.ParseExpression(p.Exact)
+		if err != nil {
+			return models.BVPResult{}, fmt.Errorf("invalid exact: %v", err)
+		}
+		if err := exactExpr.CheckVariables("x"); err != nil {
+			return models.BVPResult{}, fmt.Errorf("invalid exact: %v", err)
+		}
+		exact = exactExpr.Func("x")
+	}
+	if p.Lower >= p.Upper {
+		return models.BVPResult{}, fmt.Errorf("lower must be less than upper")
+	}
+	if p.Intervals < 2 || p.Intervals > bvpMaxIntervals {
+		return models.BVPResult{}, fmt.Errorf("intervals must be between 2 and %d", bvpMaxIntervals)
+	}
+	if p.E <= 0 {
+		return models.BVPResult{}, fmt.Errorf("e must be greater than 0")
+	}
+
+	f := func(x, y, yp float64) float64 {
+		return expr.Evaluate(map[string]float64{"x": x, "y": y, "yp": yp})
+	}
+	n := p.Intervals
+	h := (p.Upper - p.Lower) / float64(n)
+	xs := make([]float64, n+1)
+	for i := range xs {
+		xs[i] = p.Lower + float64(i)*h
+	}
+	result := models.BVPResult{Method: method, H: h}
+	var y, yp []float64
+
+	if method == "shooting" {
+		t0, t1 := p.Slope0, p.Slope1
+		if t0 == 0 && t1 == 0 {
+			t0 = (p.Beta - p.Alpha) / (p.Upper - p.Lower)
+			t1 = t0 + 1
+		}
+		if t0 == t1 {
+			return models.BVPResult{}, fmt.Errorf("slope0 and slope1 must be different")
+		}
+		miss := func(it int, t float64) (float64, error) {
+			y, yp = shoot(f, xs, p.Alpha, t)
+			end := y[n]
+			if math.IsNaN(end) || math.IsInf(end, 0) {
+				return 0, fmt.Errorf("the solution with slope %v blew up before x = %v", t, p.Upper)
+			}
+			result.Shots = append(result.Shots, models.BVPShot{Iteration: it, Slope: t, YEnd: end, Error: end - p.Beta})
+			return end - p.Beta, nil
+		}
+		f0, err := miss(0, t0)
+		if err != nil {
+			return models.BVPResult{}, err
+		}
+		result.Converged = math.Abs(f0) <= p.E
+		if !result.Converged {
+			f1, err := miss(1, t1)
+			if err != nil {
+				return models.BVPResult{}, err
+			}
+			for it := 2; it <= bvpMaxIterations && math.Abs(f1) > p.E; it++ {
+				if f1 == f0 {
+					return models.BVPResult{}, fmt.Errorf("y(b) is the same for slopes %v and %v, so the secant step is undefined", t0, t1)
+				}
+				t0, t1, f0 = t1, t1-f1*(t1-t0)/(f1-f0), f1
+				if f1, err = miss(it, t1); err != nil {
+					return models.BVPResult{}, err
+				}
+			}
+			result.Converged = math.Abs(f1) <= p.E
+		}
+		result.Iterations = len(result.Shots)
+		result.Slope = result.Shots[len(result.Shots)-1].Slope
+	} else {
+		dfdy, err := expr.Derivative("y")
+		if err != nil {
+			return models.BVPResult{}, fmt.Errorf("cannot differentiate the function for Newton's method: %v", err)
+		}
+		dfdyp, err := expr.Derivative("yp")
+		if err != nil {
+			return models.BVPResult{}, fmt.Errorf("cannot differentiate the function for Newton's method: %v", err)
+		}
+		// Start from the line through the boundary values.
+		w := make([]float64, n+1)
+		for i, x := range xs {
+			w[i] = p.Alpha + (p.Beta-p.Alpha)*(x-p.Lower)/(p.Upper-p.Lower)
+		}
+		m := n - 1
+		for it := 1; it <= bvpMaxIterations; it++ {
+			a, b, c, rhs := make([]float64, m), make([]float64, m), make([]float64, m), make([]float64, m)
+			residual := 0.0
+			for i := 1; i < n; i++ {
+				scope := map[string]float64{"x": xs[i], "y": w[i], "yp": (w[i+1] - w[i-1]) / (2 * h)}
+				fy, fyp := dfdy.Evaluate(scope), dfdyp.Evaluate(scope)
+				F := (w[i-1]-2*w[i]+w[i+1])/(h*h) - expr.Evaluate(scope)
+				if math.IsNaN(F) || math.IsInf(F, 0) || math.IsNaN(fy+fyp) || math.IsInf(fy+fyp, 0) {
+					return models.BVPResult{}, fmt.Errorf("function is not defined at x = %v", xs[i])
+				}
+				a[i-1], b[i-1], c[i-1] = 1/(h*h)+fyp/(2*h), -2/(h*h)-fy, 1/(h*h)-fyp/(2*h)
+				rhs[i-1] = -F
+				residual = math.Max(residual, math.Abs(F))
+			}
+			delta, err := solveTridiagonal(a, b, c, rhs)
+			if err != nil {
+				return models.BVPResult{}, err
+			}
+			correction := 0.0
+			for i, d := range delta {
+				w[i+1] += d
+				correction = math.Max(correction, math.Abs(d))
+			}
+			if math.IsNaN(correction) || correction > pdeBlowUp {
+				return models.BVPResult{}, fmt.Errorf("Newton's method diverged")
+			}
+			result.Newton = append(result.Newton, models.BVPNewtonStep{Iteration: it, Residual: residual, Correction: correction})
+			result.Iterations = it
+			if correction <= p.E {
+				result.Converged = true
+				break
+			}
+		}
+		y, yp = w, make([]float64, n+1)
+		for i := 1; i < n; i++ {
+			yp[i] = (w[i+1] - w[i-1]) / (2 * h)
+		}
+		yp[0] = (-3*w[0] + 4*w[1] - w[2]) / (2 * h)
+		yp[n] = (3*w[n] - 4*w[n-1] + w[n-2]) / (2 * h)
+		result.Slope = yp[0]
+	}
+
+	for i, x := range xs {
+		point := models.BVPPoint{X: x, Y: y[i], YP: yp[i]}
+		if exact != nil {
+			if v := exact(x); !math.IsNaN(v) && !math.IsInf(v, 0) {
+				point.Exact = v
+				point.Error = math.Abs(v - y[i])
+				result.MaxError = math.Max(result.MaxError, point.Error)
+			}
+		}
+		result.Table = append(result.Table, point)
+	}
+	return result, nil
+}
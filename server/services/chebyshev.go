@@ -0,0 +1,191 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	chebyshevMaxPoints = 4097
+	// chebyshevFirstPoints is where the adaptive construction starts; it
+	// then doubles the intervals (17, 33, 65, ...) as chebfun does.
+	chebyshevFirstPoints = 17
+	chebyshevGridPoints  = 2000
+)
+
+// chebyshevNodes returns n nodes of the first kind, cos(pi (j + 1/2) / n),
+// or of the second kind, cos(pi j / (n - 1)), on [-1, 1].
+func chebyshevNodes(n, kind int) []float64 {
+	nodes := make([]float64, n)
+	for j := range nodes {
+		if kind == 1 {
+			nodes[j] = math.Cos(math.Pi * (float64(j) + 0.5) / float64(n))
+		} else if n > 1 {
+			nodes[j] = math.Cos(math.Pi * float64(j) / float64(n-1))
+		}
+	}
+	return nodes
+}
+
+// chebyshevCoefficients turns values at the nodes into the coefficients of
+// the interpolant sum c_k T_k(t), using the discrete orthogonality of the
+// cosines at the nodes.
+func chebyshevCoefficients(values []float64, kind int) []float64 {
+	n := len(values)
+	coefficients := make([]float64, n)
+	if n == 1 {
+		coefficients[0] = values[0]
+		return coefficients
+	}
+	if kind == 1 {
+		// cos(pi k (2j + 1) / 2n) only depends on k (2j + 1) mod 4n.
+		table := make([]float64, 4*n)
+		for i := range table {
+			table[i] = math.Cos(math.Pi * float64(i) / float64(2*n))
+		}
+		for k := range coefficients {
+			sum := 0.0
+			for j, v := range values {
+				sum += v * table[(k*(2*j+1))%(4*n)]
+			}
+			coefficients[k] = 2 * sum / float64(n)
+		}
+		coefficients[0] /= 2
+		return coefficients
+	}
+	m := n - 1
+	// cos(pi j k / m) only depends on j k mod 2m.
+	table := make([]float64, 2*m)
+	for i := range table {
+		table[i] = math.Cos(math.Pi * float64(i) / float64(m))
+	}
+	for k := range coefficients {
+		sum := 0.0
+		for j, v := range values {
+			term := v * table[(j*k)%(2*m)]
+			if j == 0 || j == m {
+				term /= 2
+			}
+			sum += term
+		}
+		coefficients[k] = 2 * sum / float64(m)
+	}
+	coefficients[0] /= 2
+	coefficients[m] /= 2
+	return coefficients
+}
+
+// clenshaw evaluates sum c_k T_k(t).
+func clenshaw(coefficients []float64, t float64) float64 {
+	b1, b2 := 0.0, 0.0
+	for k := len(coefficients) - 1; k >= 1; k-- {
+		b1, b2 = 2*t*b1-b2+coefficients[k], b1
+	}
+	return t*b1 - b2 + coefficients[0]
+}
+
+// chopCoefficients keeps the coefficients up to the last one larger than
+// tolerance times the largest, and reports whether the discarded tail
+// covered at least the last two coefficients, i.e. the series was resolved.
+func chopCoefficients(coefficients []float64, tolerance float64) ([]float64, bool) {
+	scale := 0.0
+	for _, c := range coefficients {
+		scale = math.Max(scale, math.Abs(c))
+	}
+	last := len(coefficients) - 1
+	for last > 0 && math.Abs(coefficients[last]) <= tolerance*scale {
+		last--
+	}
+	return coefficients[:last+1], last+2 < len(coefficients) || scale == 0
+}
+
+// solveChebyshev samples the function at Chebyshev nodes on [lower, upper]
+// and builds its Chebyshev series. With a tolerance and no point count the
+// number of points doubles until the tail of the series falls below the
+// tolerance, which is then chopped off.
+func solveChebyshev(p models.Chebyshev) (models.ChebyshevResult, error) {
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.ChebyshevResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.ChebyshevResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if p.Lower >= p.Upper {
+		return models.ChebyshevResult{}, fmt.Errorf("lower must be less than upper")
+	}
+	kind := p.Kind
+	if kind == 0 {
+		kind = 2
+	}
+	if kind != 1 && kind != 2 {
+		return models.ChebyshevResult{}, fmt.Errorf("kind must be 1 or 2")
+	}
+	if p.Tolerance < 0 {
+		return models.ChebyshevResult{}, fmt.Errorf("tolerance cannot be negative")
+	}
+	adaptive := p.Points == 0
+	if adaptive && p.Tolerance == 0 {
+		return models.ChebyshevResult{}, fmt.Errorf("points or tolerance is required")
+	}
+	if p.Points < 0 || p.Points > chebyshevMaxPoints {
+		return models.ChebyshevResult{}, fmt.Errorf("points must be between 1 and %d", chebyshevMaxPoints)
+	}
+	if kind == 2 && p.Points == 1 {
+		return models.ChebyshevResult{}, fmt.Errorf("points must be at least 2 for nodes of the second kind")
+	}
+
+	f := expr.Func("x")
+	toX := func(t float64) float64 { return (p.Lower+p.Upper)/2 + (p.Upper-p.Lower)/2*t }
+	toT := func(x float64) float64 { return (2*x - p.Lower - p.Upper) / (p.Upper - p.Lower) }
+
+	n := p.Points
+	if adaptive {
+		n = chebyshevFirstPoints
+	}
+	result := models.ChebyshevResult{Kind: kind}
+	for {
+		nodes := chebyshevNodes(n, kind)
+		values := make([]float64, n)
+		for j, t := range nodes {
+			values[j] = f(toX(t))
+			if math.IsNaN(values[j]) || math.IsInf(values[j], 0) {
+				return models.ChebyshevResult{}, fmt.Errorf("function is not defined at x = %v", toX(t))
+			}
+		}
+		coefficients := chebyshevCoefficients(values, kind)
+		result.Coefficients, result.Resolved = coefficients, true
+		if p.Tolerance > 0 {
+			result.Coefficients, result.Resolved = chopCoefficients(coefficients, p.Tolerance)
+		}
+		result.Nodes = make([]float64, n)
+		for j, t := range nodes {
+			result.Nodes[j] = toX(t)
+		}
+		if !adaptive || result.Resolved || 2*n-1 > chebyshevMaxPoints {
+			break
+		}
+		n = 2*n - 1
+	}
+	result.Points = n
+	result.Degree = len(result.Coefficients) - 1
+
+	for i := 0; i <= chebyshevGridPoints; i++ {
+		x := p.Lower + (p.Upper-p.Lower)*float64(i)/chebyshevGridPoints
+		fx := f(x)
+		if math.IsNaN(fx) || math.IsInf(fx, 0) {
+			continue
+		}
+		if e := math.Abs(fx - clenshaw(result.Coefficients, toT(x))); e >= result.MaxError {
+			result.MaxError, result.MaxErrorAt = e, x
+		}
+	}
+	result.Value = clenshaw(result.Coefficients, toT(p.Xvalue))
+	if fx := f(p.Xvalue); !math.IsNaN(fx) && !math.IsInf(fx, 0) {
+		result.Error = math.Abs(fx - result.Value)
+	}
+	return result, nil
+}
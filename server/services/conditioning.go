@@ -0,0 +1,262 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+)
+
+const (
+	refinementMaxSteps = 10
+	hagerMaxIterations = 5
+)
+
+// luFactors holds PA = LU with unit lower L and U packed into one matrix.
+type luFactors[T float32 | float64] struct {
+	lu   [][]T
+	perm []int
+}
+
+// luFactor runs Gaussian elimination with partial pivoting in precision T.
+func luFactor[T float32 | float64](A [][]float64) (*luFactors[T], error) {
+	n := len(A)
+	f := &luFactors[T]{lu: make([][]T, n), perm: make([]int, n)}
+	for i := range A {
+		f.lu[i] = make([]T, n)
+		for j := range A[i] {
+			f.lu[i][j] = T(A[i][j])
+		}
+		f.perm[i] = i
+	}
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if abs(f.lu[i][k]) > abs(f.lu[pivot][k]) {
+				pivot = i
+			}
+		}
+		if f.lu[pivot][k] == 0 {
+			return nil, fmt.Errorf("matrix is singular")
+		}
+		f.lu[k], f.lu[pivot] = f.lu[pivot], f.lu[k]
+		f.perm[k], f.perm[pivot] = f.perm[pivot], f.perm[k]
+		for i := k + 1; i < n; i++ {
+			f.lu[i][k] /= f.lu[k][k]
+			for j := k + 1; j < n; j++ {
+				f.lu[i][j] -= f.lu[i][k] * f.lu[k][j]
+			}
+		}
+	}
+	return f, nil
+}
+
+// solve returns x with Ax = b, rounding b to precision T first.
+func (f *luFactors[T]) solve(b []float64) []float64 {
+	n := len(b)
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := T(b[f.perm[i]])
+		for j := 0; j < i; j++ {
+			sum -= f.lu[i][j] * y[j]
+		}
+		y[i] = sum
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			y[i] -= f.lu[i][j] * y[j]
+		}
+		y[i] /= f.lu[i][i]
+	}
+	x := make([]float64, n)
+	for i := range y {
+		x[i] = float64(y[i])
+	}
+	return x
+}
+
+// solveTranspose returns x with A^T x = b.
+func (f *luFactors[T]) solveTranspose(b []float64) []float64 {
+	n := len(b)
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := T(b[i])
+		for j := 0; j < i; j++ {
+			sum -= f.lu[j][i] * y[j]
+		}
+		y[i] = sum / f.lu[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			y[i] -= f.lu[j][i] * y[j]
+		}
+	}
+	x := make([]float64, n)
+	for i := range y {
+		x[f.perm[i]] = float64(y[i])
+	}
+	return x
+}
+
+func abs[T float32 | float64](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// inverseOneNorm estimates ||B||_1 for B = A^-1 (or A^-T when transposed)
+// with Hager's method and Higham's extra test vector, using only solves.
+func inverseOneNorm(f *luFactors[float64], transposed bool) float64 {
+	n := len(f.perm)
+	apply, applyT := f.solve, f.solveTranspose
+	if transposed {
+		apply, applyT = f.solveTranspose, f.solve
+	}
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	estimate := 0.0
+	for it := 0; it < hagerMaxIterations; it++ {
+		y := apply(x)
+		estimate = oneNorm(y)
+		xi := make([]float64, n)
+		for i := range y {
+			xi[i] = math.Copysign(1, y[i])
+		}
+		z := applyT(xi)
+		j, largest := 0, 0.0
+		for i := range z {
+			if math.Abs(z[i]) > largest {
+				j, largest = i, math.Abs(z[i])
+			}
+		}
+		if largest <= vectorDot(z, x) {
+			break
+		}
+		x = make([]float64, n)
+		x[j] = 1
+	}
+
+	alternating := make([]float64, n)
+	for i := range alternating {
+		alternating[i] = 1
+		if n > 1 {
+			alternating[i] += float64(i) / float64(n-1)
+		}
+		if i%2 == 1 {
+			alternating[i] = -alternating[i]
+		}
+	}
+	return math.Max(estimate, 2*oneNorm(apply(alternating))/float64(3*n))
+}
+
+func oneNorm(v []float64) float64 {
+	sum := 0.0
+	for _, value := range v {
+		sum += math.Abs(value)
+	}
+	return sum
+}
+
+func infNorm(v []float64) float64 {
+	largest := 0.0
+	for _, value := range v {
+		largest = math.Max(largest, math.Abs(value))
+	}
+	return largest
+}
+
+// matrixNorms returns the largest absolute column sum and row sum of A.
+func matrixNorms(A [][]float64) (float64, float64) {
+	norm1, normInf := 0.0, 0.0
+	for i := range A {
+		normInf = math.Max(normInf, oneNorm(A[i]))
+		norm1 = math.Max(norm1, oneNorm(column(A, i)))
+	}
+	return norm1, normInf
+}
+
+// analyzeMatrix solves Ax = b with LU in double precision and reports how
+// much the answer can be trusted, then refines a single precision LU
+// solution with residuals computed in double precision.
+func analyzeMatrix(matrix models.Matrix) (models.MatrixAnalysisResult, error) {
+	A, b, err := newDenseSystem(matrix.MatrixSize, matrix.MatrixData, matrix.ConstantData)
+	if err != nil {
+		return models.MatrixAnalysisResult{}, err
+	}
+	lu, err := luFactor[float64](A)
+	if err != nil {
+		return models.MatrixAnalysisResult{}, err
+	}
+	residual := func(x []float64) []float64 {
+		r := matrixVector(A, x)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+		return r
+	}
+
+	x := lu.solve(b)
+	norm1, normInf := matrixNorms(A)
+	r := residual(x)
+	result := models.MatrixAnalysisResult{
+		X:        x,
+		Kappa1:   norm1 * inverseOneNorm(lu, false),
+		KappaInf: normInf * inverseOneNorm(lu, true),
+		Residual: infNorm(r),
+	}
+	if denominator := normInf*infNorm(x) + infNorm(b); denominator > 0 {
+		result.BackwardError = result.Residual / denominator
+	}
+	if denominator := normInf * infNorm(x); denominator > 0 {
+		result.ForwardErrorBound = result.KappaInf * result.Residual / denominator
+	}
+
+	single, err := luFactor[float32](A)
+	if err != nil {
+		return models.MatrixAnalysisResult{}, fmt.Errorf("matrix is singular in single precision")
+	}
+	refined := single.solve(b)
+	correction, previous := 0.0, math.Inf(1)
+	for step := 0; ; step++ {
+		r := residual(refined)
+		difference := make([]float64, len(x))
+		for i := range x {
+			difference[i] = refined[i] - x[i]
+		}
+		record := models.RefinementStep{
+			Step:       step,
+			X:          append([]float64(nil), refined...),
+			Residual:   infNorm(r),
+			Correction: correction,
+		}
+		if xNorm := infNorm(x); xNorm > 0 {
+			record.Error = infNorm(difference) / xNorm
+		}
+		result.Refinement = append(result.Refinement, record)
+		// Stop at the step limit, at full precision, or once the corrections
+		// no longer halve and refinement has stagnated.
+		if step == refinementMaxSteps || (step > 0 && (correction <= machineEpsilon || correction > previous/2)) {
+			break
+		}
+		if step > 0 {
+			previous = correction
+		}
+
+		d := single.solve(r)
+		if math.IsNaN(infNorm(d)) || math.IsInf(infNorm(d), 0) {
+			return models.MatrixAnalysisResult{}, fmt.Errorf("iterative refinement diverged")
+		}
+		for i := range refined {
+			refined[i] += d[i]
+		}
+		correction = 0
+		if refinedNorm := infNorm(refined); refinedNorm > 0 {
+			correction = infNorm(d) / refinedNorm
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"fmt"
+	"math"
+)
+
+func vectorDot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+func vectorNorm(a []float64) float64 {
+	return math.Sqrt(vectorDot(a, a))
+}
+
+func vectorScaled(a []float64, factor float64) []float64 {
+	result := make([]float64, len(a))
+	for i, v := range a {
+		result[i] = v * factor
+	}
+	return result
+}
+
+func identityMatrix(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		m[i][i] = 1
+	}
+	return m
+}
+
+func matrixVector(m [][]float64, v []float64) []float64 {
+	result := make([]float64, len(m))
+	for i, row := range m {
+		result[i] = vectorDot(row, v)
+	}
+	return result
+}
+
+// solveLinearSystem solves Ax = b by Gaussian elimination with partial
+// pivoting. A and b are left unchanged.
+func solveLinearSystem(A [][]float64, b []float64) ([]float64, error) {
+	n := len(A)
+	m := make([][]float64, n)
+	for i := range A {
+		m[i] = append(append([]float64(nil), A[i]...), b[i])
+	}
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(m[i][k]) > math.Abs(m[pivot][k]) {
+				pivot = i
+			}
+		}
+		if m[pivot][k] == 0 {
+			return nil, fmt.Errorf("matrix is singular")
+		}
+		m[k], m[pivot] = m[pivot], m[k]
+		for i := k + 1; i < n; i++ {
+			factor := m[i][k] / m[k][k]
+			for j := k; j <= n; j++ {
+				m[i][j] -= factor * m[k][j]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := m[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= m[i][j] * x[j]
+		}
+		x[i] = sum / m[i][i]
+	}
+	return x, nil
+}
+
+// invertMatrix returns the inverse of A, column by column.
+func invertMatrix(A [][]float64) ([][]float64, error) {
+	n := len(A)
+	inverse := make([][]float64, n)
+	for i := range inverse {
+		inverse[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		e := make([]float64, n)
+		e[j] = 1
+		column, err := solveLinearSystem(A, e)
+		if err != nil {
+			return nil, err
+		}
+		for i := range column {
+			inverse[i][j] = column[i]
+		}
+	}
+	return inverse, nil
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	derivativeMaxOrder = 20
+	// symbolicMaxOrder and symbolicMaxSize stop derivative expressions
+	// that grow too long to be useful.
+	symbolicMaxOrder = 10
+	symbolicMaxSize  = 5000
+	// complexStep is the step of the first order complex-step method. There
+	// is no subtraction, so it can be far below the rounding level of x.
+	complexStep = 1e-20
+	// contourPoints is the number of points on the circle used by the
+	// complex-step method for higher orders.
+	contourPoints = 64
+)
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result
+}
+
+// finiteDifferences returns the forward, backward and central n-th order
+// differences as the client pages compute them:
+//
+//	forward  = sum (-1)^(n-k) C(n,k) f(x + kh) / h^n
+//	backward = sum (-1)^k C(n,k) f(x - kh) / h^n
+//	central  = sum (-1)^k C(n,k) f(x + (n-2k)h) / (2h)^n
+func finiteDifferences(f func(float64) float64, x, h float64, n int) (float64, float64, float64) {
+	forward, backward, central := 0.0, 0.0, 0.0
+	for k := 0; k <= n; k++ {
+		c := binomial(n, k)
+		if k%2 == 1 {
+			c = -c
+		}
+		sign := 1.0
+		if n%2 == 1 {
+			sign = -1
+		}
+		forward += sign * c * f(x+float64(k)*h)
+		backward += c * f(x-float64(k)*h)
+		central += c * f(x+float64(n-2*k)*h)
+	}
+	return forward / math.Pow(h, float64(n)), backward / math.Pow(h, float64(n)), central / math.Pow(2*h, float64(n))
+}
+
+// complexStepDerivative returns Im f(x + ih) / h for the first derivative.
+// Higher orders use the Cauchy integral on a circle of radius r around x
+// (Lyness and Moler), f^(n)(x) = n! / r^n * mean(f(x + r w^k) w^(-kn)),
+// which is the trapezoidal rule and converges quickly for analytic f.
+func complexStepDerivative(expr *utils.Expression, x, r float64, n int) (float64, error) {
+	if n == 1 {
+		v, err := expr.EvaluateComplex("x", complex(x, complexStep))
+		return imag(v) / complexStep, err
+	}
+	sum := 0.0
+	for k := 0; k < contourPoints; k++ {
+		w := cmplx.Exp(complex(0, 2*math.Pi*float64(k)/contourPoints))
+		v, err := expr.EvaluateComplex("x", complex(x, 0)+complex(r, 0)*w)
+		if err != nil {
+			return 0, err
+		}
+		sum += real(v * cmplx.Pow(w, complex(-float64(n), 0)))
+	}
+	return factorial(n) / math.Pow(r, float64(n)) * sum / contourPoints, nil
+}
+
+// solveNumericalDiff differentiates the function with forward-mode
+// automatic differentiation, which is exact up to rounding, and reports the
+// finite difference and complex-step estimates with their errors against it.
+func solveNumericalDiff(p models.NumericalDiff) (models.NumericalDiffResult, error) {
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.NumericalDiffResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.NumericalDiffResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if p.Order < 1 || p.Order > derivativeMaxOrder {
+		return models.NumericalDiffResult{}, fmt.Errorf("order must be between 1 and %d", derivativeMaxOrder)
+	}
+	if p.H == 0 {
+		return models.NumericalDiffResult{}, fmt.Errorf("h cannot be zero")
+	}
+	x, h, n := float64(p.X), float64(p.H), p.Order
+
+	jet, err := expr.EvaluateJet("x", x, n)
+	if err != nil {
+		return models.NumericalDiffResult{}, err
+	}
+	result := models.NumericalDiffResult{Method: "taylor"}
+	switch n {
+	case 1:
+		result.Method = "dual"
+	case 2:
+		result.Method = "hyper-dual"
+	}
+	for k, a := range jet {
+		d := a * factorial(k)
+		if math.IsNaN(d) || math.IsInf(d, 0) {
+			return models.NumericalDiffResult{}, fmt.Errorf("the derivative of order %d is not defined at x = %v", k, x)
+		}
+		result.Derivatives = append(result.Derivatives, d)
+	}
+	result.Derivative = result.Derivatives[n]
+	if derivatives, err := symbolicDerivatives(expr, n); err == nil {
+		result.Expression = derivatives[n-1].String()
+	}
+
+	forward, backward, central := finiteDifferences(expr.Func("x"), x, h, n)
+	complexStepValue, err := complexStepDerivative(expr, x, math.Abs(h), n)
+	if err != nil {
+		return models.NumericalDiffResult{}, err
+	}
+	for _, estimate := range []struct {
+		method string
+		value  float64
+	}{
+		{"forward", forward},
+		{"backward", backward},
+		{"central", central},
+		{"complex-step", complexStepValue},
+	} {
+		if math.IsNaN(estimate.value) || math.IsInf(estimate.value, 0) {
+			return models.NumericalDiffResult{}, fmt.Errorf("the %s estimate is not finite", estimate.method)
+		}
+		record := models.DerivativeEstimate{
+			Method: estimate.method,
+			Value:  estimate.value,
+			Error:  math.Abs(estimate.value - result.Derivative),
+		}
+		if result.Derivative != 0 {
+			record.RelativeError = record.Error / math.Abs(result.Derivative) * 100
+		}
+		result.Estimates = append(result.Estimates, record)
+	}
+	return result, nil
+}
+
+// symbolicDerivatives returns the simplified derivatives of orders 1 to n.
+// When one grows past symbolicMaxSize, the lower orders are returned along
+// with an error naming the order that stopped it.
+func symbolicDerivatives(expr *utils.Expression, n int) ([]*utils.Expression, error) {
+	var derivatives []*utils.Expression
+	d := expr
+	for k := 1; k <= n; k++ {
+		var err error
+		if d, err = d.Derivative("x"); err != nil {
+			return nil, err
+		}
+		if d.Size() > symbolicMaxSize {
+			return derivatives, fmt.Errorf("the derivative of order %d is too long to show", k)
+		}
+		derivatives = append(derivatives, d)
+	}
+	return derivatives, nil
+}
+
+// solveSymbolicDiff differentiates the function symbolically and evaluates
+// every derivative at x.
+func solveSymbolicDiff(p models.SymbolicDiff) (models.SymbolicDiffResult, error) {
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.SymbolicDiffResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.SymbolicDiffResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if p.Order < 1 || p.Order > symbolicMaxOrder {
+		return models.SymbolicDiffResult{}, fmt.Errorf("order must be between 1 and %d", symbolicMaxOrder)
+	}
+	result := models.SymbolicDiffResult{}
+	derivatives, err := symbolicDerivatives(expr, p.Order)
+	if err != nil {
+		if len(derivatives) == 0 {
+			return models.SymbolicDiffResult{}, err
+		}
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%v, so only orders up to %d are returned", err, len(derivatives)))
+	}
+
+	simplified := expr.Simplify()
+	for k, d := range append([]*utils.Expression{simplified}, derivatives...) {
+		value := d.Evaluate(map[string]float64{"x": p.X})
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return models.SymbolicDiffResult{}, fmt.Errorf("the derivative of order %d is not defined at x = %v", k, p.X)
+		}
+		result.Derivatives = append(result.Derivatives, models.SymbolicDerivative{
+			Order:      k,
+			Expression: d.String(),
+			Value:      value,
+		})
+	}
+	return result, nil
+}
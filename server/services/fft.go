@@ -0,0 +1,219 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	fftMaxSamples = 4096
+	// fftMaxQueries bounds xvalues, each of which sums the whole series.
+	fftMaxQueries = 1000
+)
+
+// radix2FFT returns the DFT X_k = sum_j x_j exp(-2 pi i j k / n) of a
+// sequence whose length is a power of two, by the iterative Cooley–Tukey
+// algorithm. Inverse uses exp(+2 pi i j k / n) and does not divide by n.
+func radix2FFT(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	a := make([]complex128, n)
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := range x {
+		a[bits.Reverse64(uint64(i))>>shift] = x[i]
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	for size := 2; size <= n; size *= 2 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				u, v := a[start+k], w*a[start+k+size/2]
+				a[start+k], a[start+k+size/2] = u+v, u-v
+				w *= step
+			}
+		}
+	}
+	return a
+}
+
+// bluesteinFFT returns the DFT of a sequence of any length. With
+// jk = (j^2 + k^2 - (k-j)^2) / 2 the DFT becomes a convolution with the
+// chirp exp(pi i m^2 / n), which is done with radix-2 FFTs of a padded size.
+func bluesteinFFT(x []complex128) []complex128 {
+	n := len(x)
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		// k^2 mod 2n keeps the angle accurate for large k.
+		chirp[k] = cmplx.Rect(1, -math.Pi*float64((k*k)%(2*n))/float64(n))
+	}
+	m := 1
+	for m < 2*n-1 {
+		m *= 2
+	}
+	a, b := make([]complex128, m), make([]complex128, m)
+	for j := range x {
+		a[j] = x[j] * chirp[j]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+	fa, fb := radix2FFT(a, false), radix2FFT(b, false)
+	for i := range fa {
+		fa[i] *= fb[i]
+	}
+	convolution := radix2FFT(fa, true)
+	result := make([]complex128, n)
+	for k := range result {
+		result[k] = chirp[k] * convolution[k] / complex(float64(m), 0)
+	}
+	return result
+}
+
+// fftSamples returns equally spaced samples, either from the points or by
+// sampling the function at lower + j h, h = (upper - lower) / samples, so
+// that upper is the start of the next period. The parsed function is
+// returned too, or nil for points.
+func fftSamples(p models.FFT) ([]float64, []float64, *utils.Expression, error) {
+	if p.Function != "" {
+		expr, err := utils.ParseExpression(p.Function)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid function: %v", err)
+		}
+		if err := expr.CheckVariables("x"); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid function: %v", err)
+		}
+		if p.Lower >= p.Upper {
+			return nil, nil, nil, fmt.Errorf("lower must be less than upper")
+		}
+		if p.Samples < 1 || p.Samples > fftMaxSamples {
+			return nil, nil, nil, fmt.Errorf("samples must be between 1 and %d", fftMaxSamples)
+		}
+		f := expr.Func("x")
+		xs, ys := make([]float64, p.Samples), make([]float64, p.Samples)
+		for j := range xs {
+			xs[j] = p.Lower + (p.Upper-p.Lower)*float64(j)/float64(p.Samples)
+			ys[j] = f(xs[j])
+			if math.IsNaN(ys[j]) || math.IsInf(ys[j], 0) {
+				return nil, nil, nil, fmt.Errorf("function is not defined at x = %v", xs[j])
+			}
+		}
+		return xs, ys, expr, nil
+	}
+
+	xs, ys, err := utils.ParsePoints(p.Points)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid points: %v", err)
+	}
+	if len(xs) > fftMaxSamples {
+		return nil, nil, nil, fmt.Errorf("points must have at most %d entries", fftMaxSamples)
+	}
+	if len(xs) > 1 {
+		h := xs[1] - xs[0]
+		for j := 1; j < len(xs); j++ {
+			if h <= 0 || math.Abs(xs[j]-xs[j-1]-h) > 1e-9*math.Max(math.Abs(h), math.Abs(xs[j])) {
+				return nil, nil, nil, fmt.Errorf("points must be equally spaced with increasing x")
+			}
+		}
+	}
+	return xs, ys, nil, nil
+}
+
+// solveFFT computes the DFT of the samples, by radix-2 when their count is
+// a power of two and by Bluestein's algorithm otherwise, and evaluates the
+// trigonometric interpolant
+//
+//	p(x) = 1/n sum_k X_k exp(2 pi i k (x - x_0) / L),  -n/2 < k <= n/2,
+//
+// where L = n h is the period, taking the real part of the Nyquist term.
+func solveFFT(p models.FFT) (models.FFTResult, error) {
+	xs, ys, expr, err := fftSamples(p)
+	if err != nil {
+		return models.FFTResult{}, err
+	}
+	var queries []float64
+	if strings.TrimSpace(p.Xvalues) != "" {
+		if queries, err = utils.ParseFloatList(p.Xvalues); err != nil {
+			return models.FFTResult{}, fmt.Errorf("invalid xvalues: %v", err)
+		}
+	}
+	if len(queries) > fftMaxQueries {
+		return models.FFTResult{}, fmt.Errorf("xvalues must have at most %d entries", fftMaxQueries)
+	}
+
+	n := len(xs)
+	period := p.Upper - p.Lower
+	if expr == nil {
+		period = float64(n)
+		if n > 1 {
+			period *= xs[1] - xs[0]
+		}
+	}
+	samples := make([]complex128, n)
+	for j, y := range ys {
+		samples[j] = complex(y, 0)
+	}
+	result := models.FFTResult{Samples: n, Period: period}
+	var spectrum []complex128
+	if n&(n-1) == 0 {
+		result.Method = "radix-2"
+		spectrum = radix2FFT(samples, false)
+	} else {
+		result.Method = "bluestein"
+		spectrum = bluesteinFFT(samples)
+	}
+
+	// frequency maps bin k to its signed index in (-n/2, n/2].
+	frequency := func(k int) int {
+		if 2*k > n {
+			return k - n
+		}
+		return k
+	}
+	for k, X := range spectrum {
+		amplitude := 2 * cmplx.Abs(X) / float64(n)
+		if k == 0 || 2*k == n {
+			amplitude /= 2
+		}
+		result.Spectrum = append(result.Spectrum, models.FFTBin{
+			Index:     k,
+			Frequency: float64(frequency(k)) / period,
+			Real:      real(X),
+			Imag:      imag(X),
+			Magnitude: cmplx.Abs(X),
+			Phase:     cmplx.Phase(X),
+			Amplitude: amplitude,
+		})
+	}
+
+	for _, x := range queries {
+		sum := complex(0, 0)
+		for k, X := range spectrum {
+			angle := 2 * math.Pi * float64(frequency(k)) * (x - xs[0]) / period
+			if 2*k == n {
+				sum += X * complex(math.Cos(angle), 0)
+			} else {
+				sum += X * cmplx.Rect(1, angle)
+			}
+		}
+		value := models.FFTValue{X: x, Value: real(sum) / float64(n)}
+		if expr != nil {
+			if exact := expr.Func("x")(x); !math.IsNaN(exact) && !math.IsInf(exact, 0) {
+				value.Exact = exact
+				value.Error = math.Abs(exact - value.Value)
+			}
+		}
+		result.Values = append(result.Values, value)
+	}
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const hermiteMaxPoints = 50
+
+// solveHermite interpolates the points in one of two ways. The hermite
+// method builds the single polynomial matching f at every point and f' at
+// every point that gives dfx, from divided differences in which those
+// points appear twice. The pchip method joins cubic pieces through the
+// points with slopes chosen by Fritsch and Carlson's rule, which keeps the
+// curve monotone wherever the data is.
+func solveHermite(p models.Hermite) (models.HermiteResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "hermite"
+	}
+	if method != "hermite" && method != "pchip" {
+		return models.HermiteResult{}, fmt.Errorf("method must be hermite or pchip")
+	}
+	points, err := utils.ParsePointFields(p.Points)
+	if err != nil {
+		return models.HermiteResult{}, fmt.Errorf("invalid points: %v", err)
+	}
+	if len(points) > hermiteMaxPoints {
+		return models.HermiteResult{}, fmt.Errorf("points must have at most %d entries", hermiteMaxPoints)
+	}
+	for i, point := range points {
+		_, hasX := point["x"]
+		_, hasFx := point["fx"]
+		if !hasX || !hasFx {
+			return models.HermiteResult{}, fmt.Errorf("point %d must have x and fx", i+1)
+		}
+	}
+	sort.SliceStable(points, func(a, b int) bool { return points[a]["x"] < points[b]["x"] })
+	for i := 1; i < len(points); i++ {
+		if points[i]["x"] == points[i-1]["x"] {
+			return models.HermiteResult{}, fmt.Errorf("x = %v appears more than once", points[i]["x"])
+		}
+	}
+
+	if method == "pchip" {
+		return pchip(points, p.Xvalue)
+	}
+
+	// z holds every x once, and twice when its derivative is known.
+	var z, f, df []float64
+	for _, point := range points {
+		z, f, df = append(z, point["x"]), append(f, point["fx"]), append(df, 0)
+		if d, ok := point["dfx"]; ok {
+			z, f, df = append(z, point["x"]), append(f, point["fx"]), append(df, d)
+		}
+	}
+	n := len(z)
+	// table[i] holds f[z_{i-k}, ..., z_i] for k = 0..i; a repeated node
+	// replaces the first divided difference by the derivative.
+	table := make([][]float64, n)
+	for i := range table {
+		table[i] = make([]float64, i+1)
+		table[i][0] = f[i]
+		for k := 1; k <= i; k++ {
+			if k == 1 && z[i] == z[i-1] {
+				table[i][1] = df[i]
+				continue
+			}
+			table[i][k] = (table[i][k-1] - table[i-1][k-1]) / (z[i] - z[i-k])
+		}
+	}
+	result := models.HermiteResult{Method: method, Nodes: z}
+	for i := range table {
+		result.Coefficients = append(result.Coefficients, table[i][i])
+	}
+	for i := n - 1; i >= 0; i-- {
+		result.Value = result.Value*(p.Xvalue-z[i]) + result.Coefficients[i]
+	}
+	return result, nil
+}
+
+// pchip returns the piecewise cubic Hermite interpolant through sorted
+// points. Inside, the slope is zero where the secants change sign and
+// otherwise their weighted harmonic mean; the end slopes come from a
+// three point formula, limited so they cannot create an overshoot.
+func pchip(points []map[string]float64, xvalue float64) (models.HermiteResult, error) {
+	n := len(points)
+	if n < 2 {
+		return models.HermiteResult{}, fmt.Errorf("pchip needs at least 2 points")
+	}
+	x, y := make([]float64, n), make([]float64, n)
+	for i, point := range points {
+		x[i], y[i] = point["x"], point["fx"]
+	}
+	h, delta := make([]float64, n-1), make([]float64, n-1)
+	for k := range h {
+		h[k] = x[k+1] - x[k]
+		delta[k] = (y[k+1] - y[k]) / h[k]
+	}
+
+	d := make([]float64, n)
+	if n == 2 {
+		d[0], d[1] = delta[0], delta[0]
+	} else {
+		for k := 1; k < n-1; k++ {
+			if delta[k-1]*delta[k] <= 0 {
+				continue
+			}
+			w1, w2 := 2*h[k]+h[k-1], h[k]+2*h[k-1]
+			d[k] = (w1 + w2) / (w1/delta[k-1] + w2/delta[k])
+		}
+		end := func(h0, h1, delta0, delta1 float64) float64 {
+			slope := ((2*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+			switch {
+			case math.Signbit(slope) != math.Signbit(delta0) || delta0 == 0:
+				return 0
+			case math.Signbit(delta0) != math.Signbit(delta1) && math.Abs(slope) > math.Abs(3*delta0):
+				return 3 * delta0
+			}
+			return slope
+		}
+		d[0] = end(h[0], h[1], delta[0], delta[1])
+		d[n-1] = end(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	}
+
+	result := models.HermiteResult{Method: "pchip", Nodes: x, Slopes: d}
+	for k := range h {
+		result.Segments = append(result.Segments, models.HermiteSegment{
+			XLower: x[k],
+			XUpper: x[k+1],
+			Coefficients: []float64{
+				y[k],
+				d[k],
+				(3*delta[k] - 2*d[k] - d[k+1]) / h[k],
+				(d[k] - 2*delta[k] + d[k+1]) / (h[k] * h[k]),
+			},
+		})
+	}
+	// Outside the data the end pieces are extended.
+	k := 0
+	for k < len(h)-1 && xvalue > x[k+1] {
+		k++
+	}
+	result.Value = polynomialValue(result.Segments[k].Coefficients, xvalue-x[k])
+	return result, nil
+}
@@ -0,0 +1,404 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	// improperMaxLevels is the number of step halvings (tanh-sinh), panel
+	// doublings (transform) or point doublings (Gauss-Laguerre/Hermite).
+	improperMaxLevels   = 10
+	improperGaussPoints = 8
+	// improperMaxGaussLevel gives 4 * 2^5 = 128 Gauss-Laguerre/Hermite points.
+	improperMaxGaussLevel = 5
+)
+
+// parseBound reads an integration limit: a number or constant expression,
+// or inf, +inf, -inf (also infinity and the ∞ sign).
+func parseBound(name, input string) (float64, error) {
+	text := strings.ToLower(strings.TrimSpace(input))
+	sign := 1.0
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		if text[0] == '-' {
+			sign = -1
+		}
+		text = strings.TrimSpace(text[1:])
+	}
+	if text == "inf" || text == "infinity" || text == "∞" {
+		return math.Inf(int(sign)), nil
+	}
+	expr, err := utils.ParseExpression(input)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	if err := expr.CheckVariables(); err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	v := expr.Evaluate(nil)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid %s: %q is not a number", name, input)
+	}
+	return v, nil
+}
+
+// gaussLaguerre returns the n point nodes and weights for
+// int_0^inf e^(-x) g(x) dx, with the weights as logarithms since they
+// underflow for the largest nodes.
+func gaussLaguerre(n int) ([]float64, []float64) {
+	nodes, logWeights := make([]float64, n), make([]float64, n)
+	z := 0.0
+	for i := 0; i < n; i++ {
+		switch i {
+		case 0:
+			z = 3 / (1 + 2.4*float64(n))
+		case 1:
+			z += 15 / (1 + 2.5*float64(n))
+		default:
+			ai := float64(i - 1)
+			z += (1 + 2.55*ai) / (1.9 * ai) * (z - nodes[i-2])
+		}
+		var p1, p2, derivative float64
+		for it := 0; it < 100; it++ {
+			// Recurrence j L_j = (2j - 1 - z) L_{j-1} - (j - 1) L_{j-2}.
+			p1, p2 = 1, 0
+			for j := 1; j <= n; j++ {
+				p1, p2 = ((2*float64(j)-1-z)*p1-(float64(j)-1)*p2)/float64(j), p1
+			}
+			derivative = float64(n) * (p1 - p2) / z
+			dz := p1 / derivative
+			z -= dz
+			if math.Abs(dz) <= 1e-14*math.Max(1, z) {
+				break
+			}
+		}
+		nodes[i] = z
+		logWeights[i] = -math.Log(math.Abs(derivative * float64(n) * p2))
+	}
+	return nodes, logWeights
+}
+
+// gaussHermite returns the n point nodes and log weights for
+// int_-inf^inf e^(-x^2) g(x) dx, using orthonormal Hermite polynomials.
+func gaussHermite(n int) ([]float64, []float64) {
+	nodes, logWeights := make([]float64, n), make([]float64, n)
+	z := 0.0
+	for i := 0; i < (n+1)/2; i++ {
+		switch i {
+		case 0:
+			z = math.Sqrt(2*float64(n)+1) - 1.85575*math.Pow(2*float64(n)+1, -0.16667)
+		case 1:
+			z -= 1.14 * math.Pow(float64(n), 0.426) / z
+		case 2:
+			z = 1.86*z - 0.86*nodes[n-1]
+		case 3:
+			z = 1.91*z - 0.91*nodes[n-2]
+		default:
+			z = 2*z - nodes[n-i+1]
+		}
+		var derivative float64
+		for it := 0; it < 100; it++ {
+			p1, p2 := math.Pow(math.Pi, -0.25), 0.0
+			for j := 1; j <= n; j++ {
+				p1, p2 = z*math.Sqrt(2/float64(j))*p1-math.Sqrt((float64(j)-1)/float64(j))*p2, p1
+			}
+			derivative = math.Sqrt(2*float64(n)) * p2
+			dz := p1 / derivative
+			z -= dz
+			if math.Abs(dz) <= 1e-14*math.Max(1, math.Abs(z)) {
+				break
+			}
+		}
+		nodes[n-1-i], nodes[i] = z, -z
+		logWeights[i] = math.Log(2 / (derivative * derivative))
+		logWeights[n-1-i] = logWeights[i]
+	}
+	return nodes, logWeights
+}
+
+// improperEstimate is one refinement level of an integral. Tail is the
+// size of the terms where a double exponential rule is cut off, 0 for the
+// other rules, and magnitude is the same sum taken over |f|.
+type improperEstimate struct {
+	value, tail, magnitude float64
+	evaluations            int
+}
+
+// improperRule computes one refinement level of an integral. It fails when
+// f is not finite at a point inside the interval.
+type improperRule func(level int) (improperEstimate, error)
+
+// improperValue evaluates f at a point inside the interval.
+func improperValue(f func(float64) float64, x float64) (float64, error) {
+	fx := f(x)
+	if math.IsNaN(fx) || math.IsInf(fx, 0) {
+		return 0, fmt.Errorf("function is not defined at x = %v", x)
+	}
+	return fx, nil
+}
+
+// tanhSinh returns the double exponential rule for the interval: tanh-sinh
+// on [a, b], exp-sinh on half lines and sinh-sinh on the whole line. Every
+// level halves the step and reuses the points of the previous levels.
+// Points that round onto a finite end point are skipped, so integrands that
+// are singular there, such as 1/sqrt(x) at 0, can be integrated; at every
+// other point f has to be finite.
+func tanhSinh(f func(float64) float64, a, b float64) improperRule {
+	tMax := 4.5
+	point := func(t float64) (float64, float64) {
+		u := math.Pi / 2 * math.Sinh(t)
+		dudt := math.Pi / 2 * math.Cosh(t)
+		switch {
+		case math.IsInf(a, -1) && math.IsInf(b, 1):
+			return math.Sinh(u), dudt * math.Cosh(u)
+		case math.IsInf(b, 1):
+			return a + math.Exp(u), dudt * math.Exp(u)
+		case math.IsInf(a, -1):
+			return b - math.Exp(u), dudt * math.Exp(u)
+		}
+		d := (b - a) / 2
+		w := d * dudt / (math.Cosh(u) * math.Cosh(u))
+		// Measure from the nearer end point so x does not round to it early.
+		if t >= 0 {
+			return b - d*2/(math.Exp(2*u)+1), w
+		}
+		return a + d*2/(math.Exp(-2*u)+1), w
+	}
+	// ends holds, for t > 0 and t < 0, the largest |t| evaluated and the
+	// size of its term.
+	sum, magnitude := 0.0, 0.0
+	var ends [2]struct{ t, term float64 }
+	return func(level int) (improperEstimate, error) {
+		h := math.Ldexp(1, -level)
+		step, start := 1, 0
+		if level > 0 {
+			// Only the odd multiples of h are new.
+			step, start = 2, 1
+		}
+		evaluations := 0
+		for k := start; float64(k)*h <= tMax; k += step {
+			for side, t := range []float64{float64(k) * h, -float64(k) * h} {
+				if k == 0 && side == 1 {
+					continue
+				}
+				x, w := point(t)
+				if x <= a || x >= b || w == 0 || math.IsInf(w, 0) {
+					continue
+				}
+				fx, err := improperValue(f, x)
+				if err != nil {
+					return improperEstimate{}, err
+				}
+				evaluations++
+				term := fx * w
+				sum += term
+				magnitude += math.Abs(term)
+				if math.Abs(t) >= ends[side].t {
+					ends[side].t, ends[side].term = math.Abs(t), math.Abs(term)
+				}
+			}
+		}
+		return improperEstimate{
+			value:       sum * h,
+			tail:        (ends[0].term + ends[1].term) * h,
+			magnitude:   magnitude * h,
+			evaluations: evaluations,
+		}, nil
+	}
+}
+
+// transformGauss maps the interval onto a finite one and applies the
+// composite Gauss-Legendre rule with 2^level panels. Infinite ends use
+// x = a + t/(1 - t) or x = t/(1 - t^2); a finite interval uses
+// x = a + (b - a)(3t^2 - 2t^3), which flattens end point singularities.
+// The Gauss points never touch the ends of the panels, but x can still
+// round onto a finite end point, and those points are skipped.
+func transformGauss(f func(float64) float64, a, b float64) improperRule {
+	lower, upper := 0.0, 1.0
+	var mapping func(t float64) (float64, float64)
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		lower = -1
+		mapping = func(t float64) (float64, float64) {
+			return t / (1 - t*t), (1 + t*t) / ((1 - t*t) * (1 - t*t))
+		}
+	case math.IsInf(b, 1):
+		mapping = func(t float64) (float64, float64) {
+			return a + t/(1-t), 1 / ((1 - t) * (1 - t))
+		}
+	case math.IsInf(a, -1):
+		mapping = func(t float64) (float64, float64) {
+			return b - t/(1-t), 1 / ((1 - t) * (1 - t))
+		}
+	default:
+		mapping = func(t float64) (float64, float64) {
+			return a + (b-a)*t*t*(3-2*t), 6 * (b - a) * t * (1 - t)
+		}
+	}
+	nodes, weights := gaussLegendre(improperGaussPoints)
+	return func(level int) (improperEstimate, error) {
+		panels := 1 << level
+		width := (upper - lower) / float64(panels)
+		var estimate improperEstimate
+		for panel := 0; panel < panels; panel++ {
+			mid := lower + (float64(panel)+0.5)*width
+			for i, node := range nodes {
+				x, dx := mapping(mid + width/2*node)
+				if math.IsInf(x, 0) || math.IsInf(dx, 0) || x <= a || x >= b {
+					continue
+				}
+				fx, err := improperValue(f, x)
+				if err != nil {
+					return improperEstimate{}, err
+				}
+				estimate.evaluations++
+				term := width / 2 * weights[i] * fx * dx
+				estimate.value += term
+				estimate.magnitude += math.Abs(term)
+			}
+		}
+		return estimate, nil
+	}
+}
+
+// weightedGauss applies Gauss-Laguerre on a half line or Gauss-Hermite on
+// the whole line with 4 * 2^level points, dividing f by the weight function.
+func weightedGauss(f func(float64) float64, a, b float64, hermite bool) improperRule {
+	return func(level int) (improperEstimate, error) {
+		n := 4 << level
+		var nodes, logWeights []float64
+		if hermite {
+			nodes, logWeights = gaussHermite(n)
+		} else {
+			nodes, logWeights = gaussLaguerre(n)
+		}
+		estimate := improperEstimate{evaluations: n}
+		for i, node := range nodes {
+			x, logScale := node, node*node
+			if !hermite {
+				x, logScale = a+node, node
+				if math.IsInf(a, -1) {
+					x = b - node
+				}
+			}
+			fx, err := improperValue(f, x)
+			if err != nil {
+				return improperEstimate{}, err
+			}
+			// 0 times an overflowing weight is 0.
+			if fx != 0 {
+				term := fx * math.Exp(logWeights[i]+logScale)
+				estimate.value += term
+				estimate.magnitude += math.Abs(term)
+			}
+		}
+		return estimate, nil
+	}
+}
+
+// solveImproperIntegral integrates over a finite or infinite interval,
+// refining until two successive levels agree to e percent. The error
+// estimate is the difference between the last two levels plus, for the
+// double exponential rules, the size of the terms where they are cut off.
+// When the terms cancel, as for an odd integrand, the levels can agree
+// exactly while the value is 0. That counts as convergence only when the
+// error is at rounding level compared with the sum over |f| and that sum
+// has stopped growing for two levels, so that 1/x on [-1, 1] or x on the
+// whole line is not reported as converged.
+func solveImproperIntegral(p models.ImproperIntegral) (models.ImproperIntegralResult, error) {
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.ImproperIntegralResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.ImproperIntegralResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	a, err := parseBound("lower", p.Lower)
+	if err != nil {
+		return models.ImproperIntegralResult{}, err
+	}
+	b, err := parseBound("upper", p.Upper)
+	if err != nil {
+		return models.ImproperIntegralResult{}, err
+	}
+	if p.E <= 0 {
+		return models.ImproperIntegralResult{}, fmt.Errorf("e must be greater than 0")
+	}
+	if a == b {
+		return models.ImproperIntegralResult{Method: p.Method, Converged: true}, nil
+	}
+	sign := 1.0
+	if a > b {
+		a, b, sign = b, a, -1
+	}
+
+	f := expr.Func("x")
+	method := strings.ToLower(p.Method)
+	var rule improperRule
+	switch method {
+	case "", "auto", "tanh-sinh":
+		method = "tanh-sinh"
+		switch {
+		case math.IsInf(a, -1) && math.IsInf(b, 1):
+			method = "sinh-sinh"
+		case math.IsInf(a, 0) || math.IsInf(b, 0):
+			method = "exp-sinh"
+		}
+		rule = tanhSinh(f, a, b)
+	case "transform":
+		rule = transformGauss(f, a, b)
+	case "gauss-laguerre":
+		if math.IsInf(a, 0) == math.IsInf(b, 0) {
+			return models.ImproperIntegralResult{}, fmt.Errorf("gauss-laguerre needs exactly one infinite bound")
+		}
+		rule = weightedGauss(f, a, b, false)
+	case "gauss-hermite":
+		if !math.IsInf(a, -1) || !math.IsInf(b, 1) {
+			return models.ImproperIntegralResult{}, fmt.Errorf("gauss-hermite needs the bounds -inf and inf")
+		}
+		rule = weightedGauss(f, a, b, true)
+	default:
+		return models.ImproperIntegralResult{}, fmt.Errorf("method must be auto, tanh-sinh, transform, gauss-laguerre or gauss-hermite")
+	}
+
+	result := models.ImproperIntegralResult{Method: method}
+	levels := improperMaxLevels
+	if strings.HasPrefix(method, "gauss") {
+		levels = improperMaxGaussLevel
+	}
+	var magnitudes []float64
+	// settled reports whether the sum over |f| changed at level l by at most
+	// half of its previous change, or only by rounding.
+	settled := func(l int) bool {
+		growth := math.Abs(magnitudes[l] - magnitudes[l-1])
+		return growth <= math.Max(math.Abs(magnitudes[l-1]-magnitudes[l-2])/2, 100*machineEpsilon*magnitudes[l])
+	}
+	for level := 0; level <= levels; level++ {
+		estimate, err := rule(level)
+		if err != nil {
+			return models.ImproperIntegralResult{}, err
+		}
+		value := sign * estimate.value
+		result.Evaluations += estimate.evaluations
+		record := models.ImproperLevel{Level: level, Value: value}
+		if level > 0 {
+			record.Error = math.Abs(value-result.Value) + estimate.tail
+		}
+		result.Levels = append(result.Levels, record)
+		result.Value, result.ErrorEstimate = value, record.Error
+		magnitudes = append(magnitudes, estimate.magnitude)
+		if (level >= 2 && value != 0 && record.Error <= p.E/100*math.Abs(value)) ||
+			(level >= 3 && record.Error <= 100*machineEpsilon*estimate.magnitude && settled(level) && settled(level-1)) {
+			result.Converged = true
+			break
+		}
+	}
+	if math.IsNaN(result.Value) || math.IsInf(result.Value, 0) {
+		return models.ImproperIntegralResult{}, fmt.Errorf("the integral is not finite")
+	}
+	return result, nil
+}
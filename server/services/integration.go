@@ -17,6 +17,12 @@ type IntegrationService interface {
 	CreateTrapezoid(c *fiber.Ctx) error
 	GetSimpson(c *fiber.Ctx) error
 	CreateSimpson(c *fiber.Ctx) error
+	GetMultipleIntegral(c *fiber.Ctx) error
+	CreateMultipleIntegral(c *fiber.Ctx) error
+	GetImproperIntegral(c *fiber.Ctx) error
+	CreateImproperIntegral(c *fiber.Ctx) error
+	GetMonteCarlo(c *fiber.Ctx) error
+	CreateMonteCarlo(c *fiber.Ctx) error
 }
 
 func NewIntegrationService(db *gorm.DB) IntegrationService {
@@ -49,12 +55,22 @@ func (s *IntegrationServiceImpl) GetTrapezoid(c *fiber.Ctx) error {
 		})
 	}
 
+	if trapezoid.Precision > 0 {
+		result, err := precisionIntegral(trapezoid.Function, trapezoid.Lower, trapezoid.Upper, trapezoid.Interval, trapezoid.Precision, false)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		trapezoid.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(trapezoid)
 }
 
 // @Tags Trapezoid
 // @Summary Create Trapezoid
-// @Description Create the trapezoid data
+// @Description Create the trapezoid data; with precision (bits) set, the composite rule is evaluated in float64 and big.Float and compared
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqTrapezoid true "Request Body"
@@ -70,10 +86,21 @@ func (s *IntegrationServiceImpl) CreateTrapezoid(c *fiber.Ctx) error {
 	}
 
 	trapezoid := models.Trapezoid{
-		Function: req.Function,
-		Lower:    req.Lower,
-		Upper:    req.Upper,
-		Interval: req.Interval,
+		Function:  req.Function,
+		Lower:     req.Lower,
+		Upper:     req.Upper,
+		Interval:  req.Interval,
+		Precision: req.Precision,
+	}
+
+	if trapezoid.Precision > 0 {
+		result, err := precisionIntegral(trapezoid.Function, trapezoid.Lower, trapezoid.Upper, trapezoid.Interval, trapezoid.Precision, false)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		trapezoid.Result = result
 	}
 
 	if err := s.db.Create(&trapezoid).Error; err != nil {
@@ -111,12 +138,22 @@ func (s *IntegrationServiceImpl) GetSimpson(c *fiber.Ctx) error {
 		})
 	}
 
+	if simpson.Precision > 0 {
+		result, err := precisionIntegral(simpson.Function, simpson.Lower, simpson.Upper, simpson.Interval, simpson.Precision, true)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		simpson.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(simpson)
 }
 
 // @Tags Simpson
 // @Summary Create Simpson
-// @Description Create the simpson data
+// @Description Create the simpson data; with precision (bits) set, the composite rule is evaluated in float64 and big.Float and compared
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqSimpson true "Request Body"
@@ -132,10 +169,21 @@ func (s *IntegrationServiceImpl) CreateSimpson(c *fiber.Ctx) error {
 	}
 
 	simpson := models.Simpson{
-		Function: req.Function,
-		Lower:    req.Lower,
-		Upper:    req.Upper,
-		Interval: req.Interval,
+		Function:  req.Function,
+		Lower:     req.Lower,
+		Upper:     req.Upper,
+		Interval:  req.Interval,
+		Precision: req.Precision,
+	}
+
+	if simpson.Precision > 0 {
+		result, err := precisionIntegral(simpson.Function, simpson.Lower, simpson.Upper, simpson.Interval, simpson.Precision, true)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		simpson.Result = result
 	}
 
 	if err := s.db.Create(&simpson).Error; err != nil {
@@ -146,3 +194,270 @@ func (s *IntegrationServiceImpl) CreateSimpson(c *fiber.Ctx) error {
 	}
 	return c.Status(fiber.StatusOK).JSON(simpson)
 }
+
+// @Tags Multiple Integral
+// @Summary Get Multiple Integral Result
+// @Description Get the multiple integral result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Multiple Integral ID"
+// @Success 200 {object} models.MultipleIntegral
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/integration/multiple/{id} [get]
+func (s *IntegrationServiceImpl) GetMultipleIntegral(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var multipleIntegral models.MultipleIntegral
+
+	if err := s.db.First(&multipleIntegral, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Multiple integral data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching multiple integral data",
+		})
+	}
+
+	result, err := solveMultipleIntegral(multipleIntegral)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	multipleIntegral.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(multipleIntegral)
+}
+
+// @Tags Multiple Integral
+// @Summary Create Multiple Integral Result
+// @Description Integrate over x in [x_lower, x_upper], y between y_lower(x) and y_upper(x) and, for a triple integral, z between z_lower(x, y) and z_upper(x, y), with the composite trapezoid or Simpson rule or Gauss-Legendre points in each dimension
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqMultipleIntegral true "Request Body"
+// @Success 201 {object} models.MultipleIntegral
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/integration/multiple [post]
+func (s *IntegrationServiceImpl) CreateMultipleIntegral(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqMultipleIntegral)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	multipleIntegral := models.MultipleIntegral{
+		Function:  req.Function,
+		Method:    req.Method,
+		XLower:    req.XLower,
+		XUpper:    req.XUpper,
+		YLower:    req.YLower,
+		YUpper:    req.YUpper,
+		ZLower:    req.ZLower,
+		ZUpper:    req.ZUpper,
+		XInterval: req.XInterval,
+		YInterval: req.YInterval,
+		ZInterval: req.ZInterval,
+	}
+
+	result, err := solveMultipleIntegral(multipleIntegral)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.db.Create(&multipleIntegral).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	multipleIntegral.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(multipleIntegral)
+}
+
+// @Tags Improper Integral
+// @Summary Get Improper Integral Result
+// @Description Get the improper integral result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Improper Integral ID"
+// @Success 200 {object} models.ImproperIntegral
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/integration/improper/{id} [get]
+func (s *IntegrationServiceImpl) GetImproperIntegral(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var improperIntegral models.ImproperIntegral
+
+	if err := s.db.First(&improperIntegral, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Improper integral data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching improper integral data",
+		})
+	}
+
+	result, err := solveImproperIntegral(improperIntegral)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	improperIntegral.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(improperIntegral)
+}
+
+// @Tags Improper Integral
+// @Summary Create Improper Integral Result
+// @Description Integrate over a finite or infinite interval (bounds may be -inf or inf) with tanh-sinh quadrature, a variable transformation with Gauss-Legendre, or Gauss-Laguerre/Hermite, refining until two levels agree to e percent
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqImproperIntegral true "Request Body"
+// @Success 201 {object} models.ImproperIntegral
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/integration/improper [post]
+func (s *IntegrationServiceImpl) CreateImproperIntegral(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqImproperIntegral)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	improperIntegral := models.ImproperIntegral{
+		Function: req.Function,
+		Lower:    req.Lower,
+		Upper:    req.Upper,
+		Method:   req.Method,
+		E:        req.E,
+	}
+
+	result, err := solveImproperIntegral(improperIntegral)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.db.Create(&improperIntegral).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	improperIntegral.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(improperIntegral)
+}
+
+// @Tags Monte Carlo Integration
+// @Summary Get Monte Carlo Integration Result
+// @Description Get the Monte Carlo integration result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Monte Carlo Integration ID"
+// @Success 200 {object} models.MonteCarlo
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/integration/monte-carlo/{id} [get]
+func (s *IntegrationServiceImpl) GetMonteCarlo(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var monteCarlo models.MonteCarlo
+
+	if err := s.db.First(&monteCarlo, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Monte Carlo integration data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching Monte Carlo integration data",
+		})
+	}
+
+	result, err := solveMonteCarlo(monteCarlo)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	monteCarlo.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(monteCarlo)
+}
+
+// @Tags Monte Carlo Integration
+// @Summary Create Monte Carlo Integration Result
+// @Description Estimate an integral over a box in one or more dimensions by seeded Monte Carlo, optionally antithetic or stratified, or by randomly shifted Sobol or Halton sequences, with its standard error and convergence series
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqMonteCarlo true "Request Body"
+// @Success 201 {object} models.MonteCarlo
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/integration/monte-carlo [post]
+func (s *IntegrationServiceImpl) CreateMonteCarlo(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqMonteCarlo)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	monteCarlo := models.MonteCarlo{
+		Function:   req.Function,
+		Lower:      req.Lower,
+		Upper:      req.Upper,
+		Method:     req.Method,
+		Samples:    req.Samples,
+		Antithetic: req.Antithetic,
+		Strata:     req.Strata,
+		Seed:       *req.Seed,
+	}
+
+	result, err := solveMonteCarlo(monteCarlo)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.db.Create(&monteCarlo).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	monteCarlo.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(monteCarlo)
+}
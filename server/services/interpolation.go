@@ -23,6 +23,18 @@ type InterpolationService interface {
 	CreateQuadraticLagrange(c *fiber.Ctx) error
 	GetQuadraticSpline(c *fiber.Ctx) error
 	CreateQuadraticSpline(c *fiber.Ctx) error
+	GetChebyshev(c *fiber.Ctx) error
+	CreateChebyshev(c *fiber.Ctx) error
+	GetPade(c *fiber.Ctx) error
+	CreatePade(c *fiber.Ctx) error
+	GetFloaterHormann(c *fiber.Ctx) error
+	CreateFloaterHormann(c *fiber.Ctx) error
+	GetBivariate(c *fiber.Ctx) error
+	CreateBivariate(c *fiber.Ctx) error
+	GetHermite(c *fiber.Ctx) error
+	CreateHermite(c *fiber.Ctx) error
+	GetFFT(c *fiber.Ctx) error
+	CreateFFT(c *fiber.Ctx) error
 }
 
 func NewInterpolationService(db *gorm.DB) InterpolationService {
@@ -330,3 +342,516 @@ func (s *InterpolationServiceImpl) CreateQuadraticSpline(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(quadraticSpline)
 }
+
+// @Tags Chebyshev
+// @Summary Get Chebyshev Result
+// @Description Get the Chebyshev interpolation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Chebyshev ID"
+// @Success 200 {object} models.Chebyshev
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/chebyshev/{id} [get]
+func (s *InterpolationServiceImpl) GetChebyshev(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var chebyshev models.Chebyshev
+
+	if err := s.DB.First(&chebyshev, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Chebyshev data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching chebyshev data",
+		})
+	}
+
+	result, err := solveChebyshev(chebyshev)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	chebyshev.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(chebyshev)
+}
+
+// @Tags Chebyshev
+// @Summary Create Chebyshev Result
+// @Description Create the Chebyshev interpolation of a function, adaptive when only a tolerance is given
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqChebyshev true "Request Body"
+// @Success 201 {object} models.Chebyshev
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/chebyshev [post]
+func (s *InterpolationServiceImpl) CreateChebyshev(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqChebyshev)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	chebyshev := models.Chebyshev{
+		Function:  req.Function,
+		Lower:     req.Lower,
+		Upper:     req.Upper,
+		Kind:      req.Kind,
+		Points:    req.Points,
+		Tolerance: req.Tolerance,
+		Xvalue:    req.Xvalue,
+	}
+
+	result, err := solveChebyshev(chebyshev)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&chebyshev).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	chebyshev.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(chebyshev)
+}
+
+// @Tags Pade
+// @Summary Get Pade Result
+// @Description Get the Padé approximant result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Pade ID"
+// @Success 200 {object} models.Pade
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/pade/{id} [get]
+func (s *InterpolationServiceImpl) GetPade(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var pade models.Pade
+
+	if err := s.DB.First(&pade, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Padé data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching Padé data",
+		})
+	}
+
+	result, err := solvePade(pade)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	pade.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(pade)
+}
+
+// @Tags Pade
+// @Summary Create Pade Result
+// @Description Create the [m/n] Padé approximant of a function from its Taylor coefficients
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqPade true "Request Body"
+// @Success 201 {object} models.Pade
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/pade [post]
+func (s *InterpolationServiceImpl) CreatePade(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqPade)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	pade := models.Pade{
+		Function: req.Function,
+		X0:       req.X0,
+		M:        req.M,
+		N:        req.N,
+		Xvalue:   req.Xvalue,
+	}
+
+	result, err := solvePade(pade)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&pade).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	pade.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(pade)
+}
+
+// @Tags Floater-Hormann
+// @Summary Get Floater-Hormann Result
+// @Description Get the Floater-Hormann rational interpolation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Floater-Hormann ID"
+// @Success 200 {object} models.FloaterHormann
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/floater-hormann/{id} [get]
+func (s *InterpolationServiceImpl) GetFloaterHormann(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var floaterHormann models.FloaterHormann
+
+	if err := s.DB.First(&floaterHormann, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Floater-Hormann data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching Floater-Hormann data",
+		})
+	}
+
+	result, err := solveFloaterHormann(floaterHormann)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	floaterHormann.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(floaterHormann)
+}
+
+// @Tags Floater-Hormann
+// @Summary Create Floater-Hormann Result
+// @Description Create the Floater-Hormann rational interpolation of the points
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqFloaterHormann true "Request Body"
+// @Success 201 {object} models.FloaterHormann
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/floater-hormann [post]
+func (s *InterpolationServiceImpl) CreateFloaterHormann(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqFloaterHormann)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	floaterHormann := models.FloaterHormann{
+		Points: req.Points,
+		D:      req.D,
+		Xvalue: req.Xvalue,
+	}
+
+	result, err := solveFloaterHormann(floaterHormann)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&floaterHormann).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	floaterHormann.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(floaterHormann)
+}
+
+// @Tags Bivariate
+// @Summary Get Bivariate Result
+// @Description Get the bivariate interpolation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Bivariate ID"
+// @Success 200 {object} models.Bivariate
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/bivariate/{id} [get]
+func (s *InterpolationServiceImpl) GetBivariate(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var bivariate models.Bivariate
+
+	if err := s.DB.First(&bivariate, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Bivariate data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching bivariate data",
+		})
+	}
+
+	result, err := solveBivariate(bivariate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	bivariate.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(bivariate)
+}
+
+// @Tags Bivariate
+// @Summary Create Bivariate Result
+// @Description Create the bilinear or bicubic interpolation of a grid of z = f(x, y) at the query points
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBivariate true "Request Body"
+// @Success 201 {object} models.Bivariate
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/bivariate [post]
+func (s *InterpolationServiceImpl) CreateBivariate(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBivariate)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	bivariate := models.Bivariate{
+		Grid:    req.Grid,
+		Method:  req.Method,
+		Queries: req.Queries,
+	}
+
+	result, err := solveBivariate(bivariate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&bivariate).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	bivariate.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(bivariate)
+}
+
+// @Tags Hermite
+// @Summary Get Hermite Result
+// @Description Get the Hermite interpolation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Hermite ID"
+// @Success 200 {object} models.Hermite
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/hermite/{id} [get]
+func (s *InterpolationServiceImpl) GetHermite(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var hermite models.Hermite
+
+	if err := s.DB.First(&hermite, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Hermite data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching hermite data",
+		})
+	}
+
+	result, err := solveHermite(hermite)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	hermite.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(hermite)
+}
+
+// @Tags Hermite
+// @Summary Create Hermite Result
+// @Description Create the Hermite interpolation of points with derivatives, or the monotone PCHIP interpolation
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqHermite true "Request Body"
+// @Success 201 {object} models.Hermite
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/hermite [post]
+func (s *InterpolationServiceImpl) CreateHermite(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqHermite)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	hermite := models.Hermite{
+		Points: req.Points,
+		Method: req.Method,
+		Xvalue: req.Xvalue,
+	}
+
+	result, err := solveHermite(hermite)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&hermite).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	hermite.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(hermite)
+}
+
+// @Tags FFT
+// @Summary Get FFT Result
+// @Description Get the FFT result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "FFT ID"
+// @Success 200 {object} models.FFT
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/interpolation/fft/{id} [get]
+func (s *InterpolationServiceImpl) GetFFT(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var fft models.FFT
+
+	if err := s.DB.First(&fft, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "FFT data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching FFT data",
+		})
+	}
+
+	result, err := solveFFT(fft)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	fft.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(fft)
+}
+
+// @Tags FFT
+// @Summary Create FFT Result
+// @Description Create the FFT spectrum of sampled data or a sampled function and evaluate its trigonometric interpolant
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqFFT true "Request Body"
+// @Success 201 {object} models.FFT
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/interpolation/fft [post]
+func (s *InterpolationServiceImpl) CreateFFT(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqFFT)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	fft := models.FFT{
+		Points:   req.Points,
+		Function: req.Function,
+		Lower:    req.Lower,
+		Upper:    req.Upper,
+		Samples:  req.Samples,
+		Xvalues:  req.Xvalues,
+	}
+
+	result, err := solveFFT(fft)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&fft).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	fft.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(fft)
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	// intervalMaxSteps bounds the number of boxes examined.
+	intervalMaxSteps = 10000
+	// intervalSplit places the bisection point slightly off the midpoint so a
+	// root at the centre of a symmetric interval is not split across two boxes.
+	intervalSplit = 0.4921875
+)
+
+// intervalBox is a part of [Xl, Xr] still to be examined; verified means it
+// is already proven to contain exactly one root.
+type intervalBox struct {
+	x        utils.Interval
+	verified bool
+}
+
+// finiteBound clamps infinite interval ends so they can be sent as JSON.
+func finiteBound(v float64) float64 {
+	return math.Max(-math.MaxFloat64, math.Min(math.MaxFloat64, v))
+}
+
+// solveIntervalNewton searches [Xl, Xr] with interval Newton steps and
+// bisection. Boxes where the interval extension of f excludes 0 are proven
+// root-free; a box is proven to hold exactly one root when f' excludes 0 and
+// either the Newton image lies inside the box or f changes sign across it.
+func solveIntervalNewton(p models.IntervalNewton) (models.IntervalRootResult, error) {
+	expr, err := utils.ParseExpression(p.Equation)
+	if err != nil {
+		return models.IntervalRootResult{}, fmt.Errorf("invalid equation: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.IntervalRootResult{}, fmt.Errorf("invalid equation: %v", err)
+	}
+	if p.E <= 0 {
+		return models.IntervalRootResult{}, fmt.Errorf("e must be greater than 0")
+	}
+	if p.Xl == p.Xr {
+		return models.IntervalRootResult{}, fmt.Errorf("xl and xr must be different")
+	}
+	xl, xr := sortedBracket(p.Xl, p.Xr)
+
+	// small reports whether the relative width (absolute near 0) is below e%.
+	small := func(x utils.Interval) bool {
+		return x.Width() <= p.E/100*math.Max(math.Max(math.Abs(x.Lo), math.Abs(x.Hi)), 1)
+	}
+	// signChange reports whether f is strictly positive at one end of x and
+	// strictly negative at the other.
+	signChange := func(x utils.Interval) bool {
+		fl, _, errL := expr.EvaluateInterval("x", utils.Point(x.Lo))
+		fr, _, errR := expr.EvaluateInterval("x", utils.Point(x.Hi))
+		if errL != nil || errR != nil {
+			return false
+		}
+		return (fl.Hi < 0 && fr.Lo > 0) || (fl.Lo > 0 && fr.Hi < 0)
+	}
+
+	result := models.IntervalRootResult{}
+	record := func(x, fx utils.Interval, step string) {
+		result.Iterations = append(result.Iterations, models.IntervalIteration{
+			Iteration: len(result.Iterations) + 1,
+			Lower:     x.Lo,
+			Upper:     x.Hi,
+			FLower:    finiteBound(fx.Lo),
+			FUpper:    finiteBound(fx.Hi),
+			Step:      step,
+		})
+	}
+	enclose := func(x utils.Interval) models.RootEnclosure {
+		return models.RootEnclosure{Lower: x.Lo, Upper: x.Hi}
+	}
+
+	stack := []intervalBox{{x: utils.Interval{Lo: xl, Hi: xr}}}
+	for len(stack) > 0 {
+		box := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if len(result.Iterations) >= intervalMaxSteps {
+			result.Undecided = append(result.Undecided, enclose(box.x))
+			continue
+		}
+
+		fx, dfx, err := expr.EvaluateInterval("x", box.x)
+		if err != nil {
+			// f is not defined anywhere in the box, so it has no root there.
+			record(box.x, utils.Interval{}, "excluded")
+			continue
+		}
+		if !fx.Contains(0) {
+			record(box.x, fx, "excluded")
+			continue
+		}
+
+		if !dfx.Contains(0) {
+			m := box.x.Mid()
+			fm, _, err := expr.EvaluateInterval("x", utils.Point(m))
+			if err == nil {
+				newton := utils.Point(m).Sub(fm.Div(dfx))
+				next, ok := newton.Intersect(box.x)
+				if !ok {
+					record(box.x, fx, "excluded")
+					continue
+				}
+				verified := box.verified || (newton.Lo > box.x.Lo && newton.Hi < box.x.Hi) || signChange(next)
+				if verified && (small(next) || next.Width() >= box.x.Width()) {
+					record(next, fx, "verified")
+					result.Enclosures = append(result.Enclosures, enclose(next))
+					continue
+				}
+				if verified || next.Width() <= box.x.Width()/2 {
+					record(box.x, fx, "newton")
+					stack = append(stack, intervalBox{x: next, verified: verified})
+					continue
+				}
+			}
+		}
+
+		if small(box.x) {
+			record(box.x, fx, "undecided")
+			result.Undecided = append(result.Undecided, enclose(box.x))
+			continue
+		}
+		m := box.x.Lo + intervalSplit*box.x.Width()
+		if m <= box.x.Lo || m >= box.x.Hi {
+			record(box.x, fx, "undecided")
+			result.Undecided = append(result.Undecided, enclose(box.x))
+			continue
+		}
+		record(box.x, fx, "bisected")
+		// Push the right half first so the left half is examined first.
+		stack = append(stack,
+			intervalBox{x: utils.Interval{Lo: m, Hi: box.x.Hi}},
+			intervalBox{x: utils.Interval{Lo: box.x.Lo, Hi: m}},
+		)
+	}
+
+	sort.Slice(result.Enclosures, func(a, b int) bool { return result.Enclosures[a].Lower < result.Enclosures[b].Lower })
+	sort.Slice(result.Undecided, func(a, b int) bool { return result.Undecided[a].Lower < result.Undecided[b].Lower })
+	// Merge undecided boxes that touch, as they usually hold the same root.
+	merged := result.Undecided[:0]
+	for _, box := range result.Undecided {
+		if n := len(merged); n > 0 && box.Lower <= merged[n-1].Upper {
+			merged[n-1].Upper = math.Max(merged[n-1].Upper, box.Upper)
+			continue
+		}
+		merged = append(merged, box)
+	}
+	result.Undecided = merged
+	result.NoRoot = len(result.Enclosures) == 0 && len(result.Undecided) == 0
+	return result, nil
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	krylovMaxIterations = 10000
+	// krylovMaxSize bounds matrix_size, which sizes the CSR row index and
+	// every work vector whatever the number of entries sent.
+	krylovMaxSize       = 100000
+	gmresDefaultRestart = 30
+	// gmresMaxRestart bounds the m+1 basis vectors of length matrix_size
+	// and the (m+1) x m Hessenberg matrix kept by GMRES(m).
+	gmresMaxRestart = 100
+)
+
+// sparseMatrix is a square matrix in compressed sparse row form.
+type sparseMatrix struct {
+	n      int
+	rowPtr []int
+	cols   []int
+	values []float64
+}
+
+// newSparseMatrix builds a CSR matrix from COO entries, summing duplicates.
+func newSparseMatrix(n int, entries []utils.SparseEntry) *sparseMatrix {
+	sorted := append([]utils.SparseEntry(nil), entries...)
+	sort.Slice(sorted, func(a, b int) bool {
+		if sorted[a].Row != sorted[b].Row {
+			return sorted[a].Row < sorted[b].Row
+		}
+		return sorted[a].Col < sorted[b].Col
+	})
+	m := &sparseMatrix{n: n, rowPtr: make([]int, n+1)}
+	for i, entry := range sorted {
+		last := len(m.cols) - 1
+		if i > 0 && last >= 0 && sorted[i-1].Row == entry.Row && m.cols[last] == entry.Col {
+			m.values[last] += entry.Value
+			continue
+		}
+		m.cols = append(m.cols, entry.Col)
+		m.values = append(m.values, entry.Value)
+		m.rowPtr[entry.Row+1] = len(m.cols)
+	}
+	for i := 1; i <= n; i++ {
+		if m.rowPtr[i] < m.rowPtr[i-1] {
+			m.rowPtr[i] = m.rowPtr[i-1]
+		}
+	}
+	return m
+}
+
+// mulVec computes y = Ax.
+func (m *sparseMatrix) mulVec(x, y []float64) {
+	for i := 0; i < m.n; i++ {
+		sum := 0.0
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			sum += m.values[k] * x[m.cols[k]]
+		}
+		y[i] = sum
+	}
+}
+
+// newSparseSystem parses the sparse matrix and right-hand side. When no
+// constants are given, b = A*ones so that the exact solution is all ones.
+func newSparseSystem(size int, matrixEntries, constantData string, e float64) (*sparseMatrix, []float64, error) {
+	if size < 1 || size > krylovMaxSize {
+		return nil, nil, fmt.Errorf("matrix_size must be between 1 and %d", krylovMaxSize)
+	}
+	if e <= 0 {
+		return nil, nil, fmt.Errorf("e must be greater than 0")
+	}
+	entries, err := utils.ParseSparseEntries(matrixEntries, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid matrix_entries: %v", err)
+	}
+	A := newSparseMatrix(size, entries)
+	b, err := newRightHandSide(A, constantData)
+	if err != nil {
+		return nil, nil, err
+	}
+	return A, b, nil
+}
+
+// newRightHandSide parses b, defaulting to A*ones when constantData is empty.
+func newRightHandSide(A *sparseMatrix, constantData string) ([]float64, error) {
+	b := make([]float64, A.n)
+	if constantData == "" {
+		ones := make([]float64, A.n)
+		for i := range ones {
+			ones[i] = 1
+		}
+		A.mulVec(ones, b)
+		return b, nil
+	}
+	values, err := utils.ParseFloatList(constantData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constant_data: %v", err)
+	}
+	if len(values) != A.n {
+		return nil, fmt.Errorf("constant_data must have %d values", A.n)
+	}
+	return values, nil
+}
+
+// krylovResult records the final solution and its true relative residual.
+func krylovResult(A *sparseMatrix, b, x []float64, iterations int, converged bool, history []float64) (models.KrylovResult, error) {
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return models.KrylovResult{}, fmt.Errorf("the iteration broke down; the matrix may be singular")
+		}
+	}
+	r := make([]float64, len(b))
+	A.mulVec(x, r)
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+	relative := 0.0
+	if bNorm := vectorNorm(b); bNorm > 0 {
+		relative = vectorNorm(r) / bNorm
+	}
+	return models.KrylovResult{
+		X:                x,
+		Iterations:       iterations,
+		Converged:        converged,
+		RelativeResidual: relative,
+		ResidualHistory:  history,
+	}, nil
+}
+
+// solveGMRES runs restarted GMRES(m) from x0 = 0 until ||b - Ax|| / ||b|| < e.
+// The least squares problem is kept triangular with Givens rotations, so the
+// residual estimate is available at every inner iteration.
+func solveGMRES(p models.GMRES) (models.KrylovResult, error) {
+	A, b, err := newSparseSystem(p.MatrixSize, p.MatrixEntries, p.ConstantData, p.E)
+	if err != nil {
+		return models.KrylovResult{}, err
+	}
+	if p.Restart > gmresMaxRestart {
+		return models.KrylovResult{}, fmt.Errorf("restart must be at most %d", gmresMaxRestart)
+	}
+	m := p.Restart
+	if m <= 0 {
+		m = gmresDefaultRestart
+	}
+	if m > p.MatrixSize {
+		m = p.MatrixSize
+	}
+
+	n := p.MatrixSize
+	x := make([]float64, n)
+	bNorm := vectorNorm(b)
+	if bNorm == 0 {
+		return krylovResult(A, b, x, 0, true, nil)
+	}
+
+	history := []float64{1}
+	r := make([]float64, n)
+	w := make([]float64, n)
+	V := make([][]float64, m+1)
+	H := make([][]float64, m+1)
+	for i := range H {
+		H[i] = make([]float64, m)
+	}
+	cs := make([]float64, m)
+	sn := make([]float64, m)
+	g := make([]float64, m+1)
+
+	total := 0
+	converged := false
+	for total < krylovMaxIterations {
+		A.mulVec(x, r)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+		beta := vectorNorm(r)
+		if beta/bNorm <= p.E {
+			converged = true
+			break
+		}
+
+		V[0] = vectorScaled(r, 1/beta)
+		for i := range g {
+			g[i] = 0
+		}
+		g[0] = beta
+
+		k := 0
+		for j := 0; j < m && total < krylovMaxIterations; j++ {
+			total++
+			A.mulVec(V[j], w)
+			// Modified Gram-Schmidt against the Krylov basis.
+			for i := 0; i <= j; i++ {
+				H[i][j] = vectorDot(w, V[i])
+				for l := range w {
+					w[l] -= H[i][j] * V[i][l]
+				}
+			}
+			wNorm := vectorNorm(w)
+			H[j+1][j] = wNorm
+
+			for i := 0; i < j; i++ {
+				t := cs[i]*H[i][j] + sn[i]*H[i+1][j]
+				H[i+1][j] = -sn[i]*H[i][j] + cs[i]*H[i+1][j]
+				H[i][j] = t
+			}
+			d := math.Hypot(H[j][j], H[j+1][j])
+			cs[j], sn[j] = H[j][j]/d, H[j+1][j]/d
+			H[j][j], H[j+1][j] = d, 0
+			g[j+1] = -sn[j] * g[j]
+			g[j] = cs[j] * g[j]
+
+			k = j + 1
+			history = append(history, math.Abs(g[j+1])/bNorm)
+			if math.Abs(g[j+1])/bNorm <= p.E || wNorm == 0 {
+				break
+			}
+			V[j+1] = vectorScaled(w, 1/wNorm)
+		}
+
+		// Back substitution for y, then x += V y.
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= H[i][l] * y[l]
+			}
+			y[i] = sum / H[i][i]
+		}
+		for i := 0; i < k; i++ {
+			for l := range x {
+				x[l] += y[i] * V[i][l]
+			}
+		}
+	}
+
+	if !converged {
+		A.mulVec(x, r)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+		converged = vectorNorm(r)/bNorm <= p.E
+	}
+	return krylovResult(A, b, x, total, converged, history)
+}
+
+// solveBiCGSTAB runs the stabilized bi-conjugate gradient method from x0 = 0
+// until ||b - Ax|| / ||b|| < e, or until the method breaks down.
+func solveBiCGSTAB(p models.BiCGSTAB) (models.KrylovResult, error) {
+	A, b, err := newSparseSystem(p.MatrixSize, p.MatrixEntries, p.ConstantData, p.E)
+	if err != nil {
+		return models.KrylovResult{}, err
+	}
+
+	n := p.MatrixSize
+	x := make([]float64, n)
+	bNorm := vectorNorm(b)
+	if bNorm == 0 {
+		return krylovResult(A, b, x, 0, true, nil)
+	}
+
+	r := append([]float64(nil), b...)
+	rHat := append([]float64(nil), b...)
+	v := make([]float64, n)
+	pv := make([]float64, n)
+	s := make([]float64, n)
+	t := make([]float64, n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	history := []float64{1}
+	iterations := 0
+	converged := false
+	for iterations < krylovMaxIterations {
+		rhoNew := vectorDot(rHat, r)
+		if rhoNew == 0 || omega == 0 {
+			break
+		}
+		iterations++
+		beta := (rhoNew / rho) * (alpha / omega)
+		for i := range pv {
+			pv[i] = r[i] + beta*(pv[i]-omega*v[i])
+		}
+		A.mulVec(pv, v)
+		alpha = rhoNew / vectorDot(rHat, v)
+		for i := range s {
+			s[i] = r[i] - alpha*v[i]
+		}
+		if vectorNorm(s)/bNorm <= p.E {
+			for i := range x {
+				x[i] += alpha * pv[i]
+			}
+			history = append(history, vectorNorm(s)/bNorm)
+			converged = true
+			break
+		}
+		A.mulVec(s, t)
+		omega = vectorDot(t, s) / vectorDot(t, t)
+		for i := range x {
+			x[i] += alpha*pv[i] + omega*s[i]
+			r[i] = s[i] - omega*t[i]
+		}
+		relative := vectorNorm(r) / bNorm
+		if math.IsNaN(relative) {
+			break
+		}
+		history = append(history, relative)
+		if relative <= p.E {
+			converged = true
+			break
+		}
+		rho = rhoNew
+	}
+	return krylovResult(A, b, x, iterations, converged, history)
+}
@@ -19,6 +19,12 @@ type LeastSquaresRegressionService interface {
 	CreatePolynomialRegression(c *fiber.Ctx) error
 	GetMultipleRegression(c *fiber.Ctx) error
 	CreateMultipleRegression(c *fiber.Ctx) error
+	GetNonlinearRegression(c *fiber.Ctx) error
+	CreateNonlinearRegression(c *fiber.Ctx) error
+	GetBSplineRegression(c *fiber.Ctx) error
+	CreateBSplineRegression(c *fiber.Ctx) error
+	GetSmoothingSpline(c *fiber.Ctx) error
+	CreateSmoothingSpline(c *fiber.Ctx) error
 }
 
 func NewLeastSquaresRegressionService(db *gorm.DB) LeastSquaresRegressionService {
@@ -210,3 +216,260 @@ func (l LeastSquaresRegressionServiceImpl) CreateMultipleRegression(c *fiber.Ctx
 
 	return c.Status(fiber.StatusOK).JSON(multipleRegression)
 }
+
+// @Tags Nonlinear Regression
+// @Summary Get Nonlinear Regression Result
+// @Description Get the nonlinear regression result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Nonlinear Regression ID"
+// @Success 200 {object} models.NonlinearRegression
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/least-squares-regression/nonlinear-regression/{id} [get]
+func (l LeastSquaresRegressionServiceImpl) GetNonlinearRegression(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var nonlinearRegression models.NonlinearRegression
+
+	if err := l.DB.First(&nonlinearRegression, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Nonlinear regression data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching nonlinear regression data",
+		})
+	}
+
+	result, err := solveNonlinearRegression(nonlinearRegression)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	nonlinearRegression.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(nonlinearRegression)
+}
+
+// @Tags Nonlinear Regression
+// @Summary Create Nonlinear Regression Result
+// @Description Fit a model that is nonlinear in its parameters, such as a*exp(b*x)+c, to the points with the Levenberg-Marquardt method
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqNonlinearRegression true "Request Body"
+// @Success 201 {object} models.NonlinearRegression
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/least-squares-regression/nonlinear-regression [post]
+func (l LeastSquaresRegressionServiceImpl) CreateNonlinearRegression(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqNonlinearRegression)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	nonlinearRegression := models.NonlinearRegression{
+		Points:     req.Points,
+		Model:      req.Model,
+		Parameters: req.Parameters,
+		Initial:    req.Initial,
+		E:          req.E,
+		Xvalue:     req.Xvalue,
+	}
+
+	result, err := solveNonlinearRegression(nonlinearRegression)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := l.DB.Create(&nonlinearRegression).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	nonlinearRegression.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(nonlinearRegression)
+}
+
+// @Tags B-Spline Regression
+// @Summary Get B-Spline Regression Result
+// @Description Get the B-spline regression result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "B-Spline Regression ID"
+// @Success 200 {object} models.BSplineRegression
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/least-squares-regression/b-spline-regression/{id} [get]
+func (l LeastSquaresRegressionServiceImpl) GetBSplineRegression(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var bSplineRegression models.BSplineRegression
+
+	if err := l.DB.First(&bSplineRegression, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "B-spline regression data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching B-spline regression data",
+		})
+	}
+
+	result, err := solveBSplineRegression(bSplineRegression)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	bSplineRegression.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(bSplineRegression)
+}
+
+// @Tags B-Spline Regression
+// @Summary Create B-Spline Regression Result
+// @Description Fit a spline with the given degree and knots to the points by least squares in the B-spline basis
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBSplineRegression true "Request Body"
+// @Success 201 {object} models.BSplineRegression
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/least-squares-regression/b-spline-regression [post]
+func (l LeastSquaresRegressionServiceImpl) CreateBSplineRegression(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBSplineRegression)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	bSplineRegression := models.BSplineRegression{
+		Points:   req.Points,
+		Degree:   req.Degree,
+		Knots:    req.Knots,
+		Segments: req.Segments,
+		Xvalue:   req.Xvalue,
+	}
+
+	result, err := solveBSplineRegression(bSplineRegression)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := l.DB.Create(&bSplineRegression).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	bSplineRegression.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(bSplineRegression)
+}
+
+// @Tags Smoothing Spline
+// @Summary Get Smoothing Spline Result
+// @Description Get the smoothing spline result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Smoothing Spline ID"
+// @Success 200 {object} models.SmoothingSpline
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/least-squares-regression/smoothing-spline/{id} [get]
+func (l LeastSquaresRegressionServiceImpl) GetSmoothingSpline(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var smoothingSpline models.SmoothingSpline
+
+	if err := l.DB.First(&smoothingSpline, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Smoothing spline data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching smoothing spline data",
+		})
+	}
+
+	result, err := solveSmoothingSpline(smoothingSpline)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	smoothingSpline.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(smoothingSpline)
+}
+
+// @Tags Smoothing Spline
+// @Summary Create Smoothing Spline Result
+// @Description Fit the cubic smoothing spline to the points, choosing lambda by GCV when it is 0
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqSmoothingSpline true "Request Body"
+// @Success 201 {object} models.SmoothingSpline
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/least-squares-regression/smoothing-spline [post]
+func (l LeastSquaresRegressionServiceImpl) CreateSmoothingSpline(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqSmoothingSpline)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	smoothingSpline := models.SmoothingSpline{
+		Points: req.Points,
+		Lambda: req.Lambda,
+		Xvalue: req.Xvalue,
+	}
+
+	result, err := solveSmoothingSpline(smoothingSpline)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := l.DB.Create(&smoothingSpline).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	smoothingSpline.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(smoothingSpline)
+}
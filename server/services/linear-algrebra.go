@@ -15,8 +15,18 @@ type LinearServiceImpl struct {
 type LinearService interface {
 	GetMatrix(c *fiber.Ctx) error
 	CreateMatrix(c *fiber.Ctx) error
+	GetMatrixAnalysis(c *fiber.Ctx) error
 	GetMatrixIteration(c *fiber.Ctx) error
 	CreateMatrixIteration(c *fiber.Ctx) error
+	GetGMRES(c *fiber.Ctx) error
+	CreateGMRES(c *fiber.Ctx) error
+	UploadGMRES(c *fiber.Ctx) error
+	GetBiCGSTAB(c *fiber.Ctx) error
+	CreateBiCGSTAB(c *fiber.Ctx) error
+	UploadBiCGSTAB(c *fiber.Ctx) error
+	GetPCG(c *fiber.Ctx) error
+	GetSOR(c *fiber.Ctx) error
+	GetQR(c *fiber.Ctx) error
 }
 
 func NewLinearService(db *gorm.DB) LinearService {
@@ -56,12 +66,22 @@ func (l LinearServiceImpl) GetMatrix(c *fiber.Ctx) error {
 			Message: "Error fetching matrix data",
 		})
 	}
+	if matrix.Precision > 0 {
+		result, err := precisionMatrix(matrix.MatrixSize, matrix.MatrixData, matrix.ConstantData, matrix.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		matrix.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(matrix)
 }
 
 // @Tags Matrix
 // @Summary Create Matrix Result
-// @Description Create the matrix result
+// @Description Create the matrix result; with precision (bits) set, Gaussian elimination is run in float64 and big.Float and compared
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqMatrix true "Request Body"
@@ -80,6 +100,17 @@ func (l *LinearServiceImpl) CreateMatrix(c *fiber.Ctx) error {
 		MatrixSize:   req.MatrixSize,
 		MatrixData:   req.MatrixData,
 		ConstantData: req.ConstantData,
+		Precision:    req.Precision,
+	}
+
+	if matrix.Precision > 0 {
+		result, err := precisionMatrix(matrix.MatrixSize, matrix.MatrixData, matrix.ConstantData, matrix.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		matrix.Result = result
 	}
 
 	if err := l.DB.Create(&matrix).Error; err != nil {
@@ -155,3 +186,427 @@ func (l *LinearServiceImpl) CreateMatrixIteration(c *fiber.Ctx) error {
 	}
 	return c.Status(fiber.StatusCreated).JSON(matrixIteration)
 }
+
+// @Tags GMRES
+// @Summary Get GMRES Result
+// @Description Get the GMRES result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "GMRES ID"
+// @Success 200 {object} models.GMRES
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/gmres/{id} [get]
+func (l *LinearServiceImpl) GetGMRES(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var gmres models.GMRES
+
+	if err := l.DB.First(&gmres, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "GMRES data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching GMRES data",
+		})
+	}
+
+	result, err := solveGMRES(gmres)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	gmres.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(gmres)
+}
+
+// @Tags GMRES
+// @Summary Create GMRES Result
+// @Description Solve a sparse system given as COO triplets "row col value, ..." with restarted GMRES(m); when constant_data is empty b = A*ones
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqGMRES true "Request Body"
+// @Success 201 {object} models.GMRES
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/linear-algrebra/gmres [post]
+func (l *LinearServiceImpl) CreateGMRES(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqGMRES)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	gmres := models.GMRES{
+		MatrixSize:    req.MatrixSize,
+		MatrixEntries: req.MatrixEntries,
+		ConstantData:  req.ConstantData,
+		E:             req.E,
+		Restart:       req.Restart,
+	}
+
+	result, err := solveGMRES(gmres)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := l.DB.Create(&gmres).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	gmres.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(gmres)
+}
+
+// @Tags GMRES
+// @Summary Upload GMRES Matrix Market File
+// @Description Solve a sparse system uploaded as a Matrix Market (.mtx) coordinate file with restarted GMRES(m)
+// @Accept multipart/form-data
+// @Produce json
+// @Param matrix formData file true "Matrix Market (.mtx) file"
+// @Param constant_data formData string false "Comma separated right-hand side, defaults to A*ones"
+// @Param e formData number true "Relative residual tolerance"
+// @Param restart formData integer false "Restart length m, defaults to 30"
+// @Success 201 {object} models.GMRES
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/linear-algrebra/gmres/upload [post]
+func (l *LinearServiceImpl) UploadGMRES(c *fiber.Ctx) error {
+	return l.CreateGMRES(c)
+}
+
+// @Tags BiCGSTAB
+// @Summary Get BiCGSTAB Result
+// @Description Get the bi CGSTAB result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "BiCGSTAB ID"
+// @Success 200 {object} models.BiCGSTAB
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/bicgstab/{id} [get]
+func (l *LinearServiceImpl) GetBiCGSTAB(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var biCGSTAB models.BiCGSTAB
+
+	if err := l.DB.First(&biCGSTAB, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Bi CGSTAB data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching bi CGSTAB data",
+		})
+	}
+
+	result, err := solveBiCGSTAB(biCGSTAB)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	biCGSTAB.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(biCGSTAB)
+}
+
+// @Tags BiCGSTAB
+// @Summary Create BiCGSTAB Result
+// @Description Solve a sparse system given as COO triplets "row col value, ..." with BiCGSTAB; when constant_data is empty b = A*ones
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBiCGSTAB true "Request Body"
+// @Success 201 {object} models.BiCGSTAB
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/linear-algrebra/bicgstab [post]
+func (l *LinearServiceImpl) CreateBiCGSTAB(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBiCGSTAB)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	biCGSTAB := models.BiCGSTAB{
+		MatrixSize:    req.MatrixSize,
+		MatrixEntries: req.MatrixEntries,
+		ConstantData:  req.ConstantData,
+		E:             req.E,
+	}
+
+	result, err := solveBiCGSTAB(biCGSTAB)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := l.DB.Create(&biCGSTAB).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	biCGSTAB.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(biCGSTAB)
+}
+
+// @Tags BiCGSTAB
+// @Summary Upload BiCGSTAB Matrix Market File
+// @Description Solve a sparse system uploaded as a Matrix Market (.mtx) coordinate file with BiCGSTAB
+// @Accept multipart/form-data
+// @Produce json
+// @Param matrix formData file true "Matrix Market (.mtx) file"
+// @Param constant_data formData string false "Comma separated right-hand side, defaults to A*ones"
+// @Param e formData number true "Relative residual tolerance"
+// @Success 201 {object} models.BiCGSTAB
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/linear-algrebra/bicgstab/upload [post]
+func (l *LinearServiceImpl) UploadBiCGSTAB(c *fiber.Ctx) error {
+	return l.CreateBiCGSTAB(c)
+}
+
+// @Tags Matrix Iteration
+// @Summary Get PCG Result
+// @Description Solve a stored symmetric positive definite matrix iteration problem with conjugate gradients using no, Jacobi, SSOR and IC(0) preconditioning, reporting the iterations to tolerance e of each; x comes from the selected preconditioner (default ic0)
+// @Accept json
+// @Produce json
+// @Param id path string true "Matrix Iteration ID"
+// @Param preconditioner query string false "none, jacobi, ssor or ic0"
+// @Param omega query number false "SSOR relaxation factor (default 1)"
+// @Success 200 {object} models.PCG
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/matrix-iteration/{id}/pcg [get]
+func (l *LinearServiceImpl) GetPCG(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	req, ok := c.Locals("req").(validations.ReqPCG)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	var matrixIteration models.MatrixIteration
+
+	if err := l.DB.First(&matrixIteration, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "matrix iteration data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching matrix iteration data",
+		})
+	}
+
+	pcg := models.PCG{
+		MatrixIteration: matrixIteration,
+		Preconditioner:  req.Preconditioner,
+		Omega:           req.Omega,
+	}
+
+	result, err := solvePCG(pcg)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	pcg.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(pcg)
+}
+
+// @Tags Matrix Iteration
+// @Summary Get SOR Result
+// @Description Solve a stored matrix iteration problem with SOR or SSOR using the given omega, or for SOR with auto the optimal omega from the Jacobi spectral radius, and compare iteration counts for omega = 0.1 ... 1.9
+// @Accept json
+// @Produce json
+// @Param id path string true "Matrix Iteration ID"
+// @Param method query string false "sor or ssor (default sor)"
+// @Param omega query number false "relaxation factor between 0 and 2"
+// @Param auto query boolean false "use the optimal omega (sor only)"
+// @Success 200 {object} models.SOR
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/matrix-iteration/{id}/sor [get]
+func (l *LinearServiceImpl) GetSOR(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	req, ok := c.Locals("req").(validations.ReqSOR)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	var matrixIteration models.MatrixIteration
+
+	if err := l.DB.First(&matrixIteration, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "matrix iteration data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching matrix iteration data",
+		})
+	}
+
+	sor := models.SOR{
+		MatrixIteration: matrixIteration,
+		Method:          req.Method,
+		Omega:           req.Omega,
+		Auto:            req.Auto,
+	}
+
+	result, err := solveSOR(sor)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	sor.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(sor)
+}
+
+// @Tags Matrix
+// @Summary Get QR Result
+// @Description Factor a stored matrix as A = QR with classical and modified Gram-Schmidt, Householder and Givens (or only the selected method), returning Q, R, the orthogonality loss ||Q^T Q - I|| and the solution of Ax = b
+// @Accept json
+// @Produce json
+// @Param id path string true "Matrix ID"
+// @Param method query string false "cgs, mgs, householder or givens"
+// @Success 200 {object} models.QR
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/matrix/{id}/qr [get]
+func (l *LinearServiceImpl) GetQR(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	req, ok := c.Locals("req").(validations.ReqQR)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	var matrix models.Matrix
+
+	if err := l.DB.First(&matrix, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "matrix data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching matrix data",
+		})
+	}
+
+	qr := models.QR{
+		Matrix: matrix,
+		Method: req.Method,
+	}
+
+	result, err := solveQR(qr)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	qr.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(qr)
+}
+
+// @Tags Matrix
+// @Summary Get Matrix Analysis
+// @Description Solve a stored matrix problem with LU and return estimates of kappa_1 and kappa_inf, the residual ||b - Ax||_inf, the normwise backward error and the forward error bound, plus mixed precision iterative refinement (float32 LU, float64 residual) with the error relative to the float64 solution after each step
+// @Accept json
+// @Produce json
+// @Param id path string true "Matrix ID"
+// @Success 200 {object} models.MatrixAnalysis
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/linear-algrebra/matrix/{id}/analysis [get]
+func (l *LinearServiceImpl) GetMatrixAnalysis(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var matrix models.Matrix
+
+	if err := l.DB.First(&matrix, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "matrix data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching matrix data",
+		})
+	}
+
+	result, err := analyzeMatrix(matrix)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.MatrixAnalysis{
+		Matrix: matrix,
+		Result: result,
+	})
+}
@@ -0,0 +1,364 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	// monteCarloMaxEvaluations keeps a request to a few seconds.
+	monteCarloMaxEvaluations = 10000000
+	// qmcReplicates is the number of independently shifted copies of a
+	// low-discrepancy sequence, whose spread gives the standard error.
+	qmcReplicates = 16
+)
+
+// sobolDirections holds, for dimensions 2 onwards, the degree s and
+// coefficients a of a primitive polynomial over GF(2) and the initial
+// direction numbers m_1..m_s, from Joe and Kuo's new-joe-kuo-6.21201.
+var sobolDirections = []struct {
+	s, a uint32
+	m    []uint32
+}{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+	{5, 11, []uint32{1, 1, 5, 1, 1}},
+	{5, 13, []uint32{1, 1, 1, 3, 11}},
+	{5, 14, []uint32{1, 3, 5, 5, 31}},
+	{6, 1, []uint32{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint32{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint32{1, 3, 1, 13, 27, 49}},
+}
+
+// monteCarloMaxDimension is the number of dimensions the Sobol table
+// covers; Halton and plain Monte Carlo use the same limit.
+var monteCarloMaxDimension = len(sobolDirections) + 1
+
+// sobolSequence returns a generator of the d-dimensional Sobol sequence,
+// starting at the origin. Point n is the previous one XORed with the
+// direction number for the lowest zero bit of n - 1 (Antonov and Saleev's
+// Gray code order), which gives the same set of points at every power of
+// two.
+func sobolSequence(d int) func() []float64 {
+	v := make([][32]uint32, d)
+	for i := 1; i <= 32; i++ {
+		v[0][i-1] = 1 << (32 - i)
+	}
+	for j := 1; j < d; j++ {
+		s, a, m := sobolDirections[j-1].s, sobolDirections[j-1].a, sobolDirections[j-1].m
+		for i := uint32(1); i <= 32; i++ {
+			if i <= s {
+				v[j][i-1] = m[i-1] << (32 - i)
+				continue
+			}
+			// V_i = V_{i-s} ^ V_{i-s} >> s ^ a_1 V_{i-1} ^ ... ^ a_{s-1} V_{i-s+1}
+			value := v[j][i-s-1] ^ v[j][i-s-1]>>s
+			for k := uint32(1); k < s; k++ {
+				if a>>(s-1-k)&1 == 1 {
+					value ^= v[j][i-k-1]
+				}
+			}
+			v[j][i-1] = value
+		}
+	}
+	x := make([]uint32, d)
+	n := 0
+	return func() []float64 {
+		if n > 0 {
+			c := 0
+			for (n-1)>>c&1 == 1 {
+				c++
+			}
+			for j := range x {
+				x[j] ^= v[j][c]
+			}
+		}
+		n++
+		point := make([]float64, d)
+		for j := range x {
+			point[j] = float64(x[j]) / (1 << 32)
+		}
+		return point
+	}
+}
+
+// haltonSequence returns a generator of the d-dimensional Halton sequence,
+// whose coordinate j is the radical inverse of n in the j-th prime base,
+// starting at n = 0.
+func haltonSequence(d int) func() []float64 {
+	var primes []int
+	for k := 2; len(primes) < d; k++ {
+		prime := true
+		for _, q := range primes {
+			if k%q == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			primes = append(primes, k)
+		}
+	}
+	n := 0
+	return func() []float64 {
+		point := make([]float64, d)
+		for j, base := range primes {
+			f, value := 1.0, 0.0
+			for k := n; k > 0; k /= base {
+				f /= float64(base)
+				value += f * float64(k%base)
+			}
+			point[j] = value
+		}
+		n++
+		return point
+	}
+}
+
+// welford accumulates a running mean and sum of squared deviations.
+type welford struct {
+	n        int
+	mean, m2 float64
+}
+
+func (w *welford) add(v float64) {
+	w.n++
+	delta := v - w.mean
+	w.mean += delta / float64(w.n)
+	w.m2 += delta * (v - w.mean)
+}
+
+func (w *welford) variance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / float64(w.n-1)
+}
+
+// monteCarloCheckpoint reports whether the convergence series records n
+// samples: every power of two from 2, and the total.
+func monteCarloCheckpoint(n, total int) bool {
+	return n >= 2 && (n&(n-1) == 0 || n == total)
+}
+
+// solveMonteCarlo estimates the integral of the function over the box
+// with the given lower and upper corners as the volume times the mean of f
+// at the sample points. The monte-carlo method draws uniform points from
+// the seeded generator, optionally in strata^d equal cells with the same
+// number of points each, and takes the standard error from the sample
+// variance. The sobol and halton methods use qmcReplicates copies of the
+// sequence, each shifted modulo 1 by a seeded random vector, and take the
+// standard error from the spread of their estimates. Antithetic sampling
+// evaluates f at u and 1 - u and uses the average as one sample.
+func solveMonteCarlo(p models.MonteCarlo) (models.MonteCarloResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "monte-carlo"
+	}
+	if method != "monte-carlo" && method != "sobol" && method != "halton" {
+		return models.MonteCarloResult{}, fmt.Errorf("method must be monte-carlo, sobol or halton")
+	}
+	lower, err := utils.ParseFloatList(p.Lower)
+	if err != nil {
+		return models.MonteCarloResult{}, fmt.Errorf("invalid lower: %v", err)
+	}
+	upper, err := utils.ParseFloatList(p.Upper)
+	if err != nil {
+		return models.MonteCarloResult{}, fmt.Errorf("invalid upper: %v", err)
+	}
+	d := len(lower)
+	if d == 0 || d != len(upper) {
+		return models.MonteCarloResult{}, fmt.Errorf("lower and upper must have the same number of values")
+	}
+	if d > monteCarloMaxDimension {
+		return models.MonteCarloResult{}, fmt.Errorf("at most %d dimensions are supported", monteCarloMaxDimension)
+	}
+	// The variables are x1..xd, and also x, y and z for the first three.
+	names, aliases := make([]string, d), []string{"x", "y", "z"}
+	allowed := make([]string, 0, 2*d)
+	for j := range names {
+		names[j] = fmt.Sprintf("x%d", j+1)
+		allowed = append(allowed, names[j])
+		if j < len(aliases) {
+			allowed = append(allowed, aliases[j])
+		}
+	}
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.MonteCarloResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables(allowed...); err != nil {
+		return models.MonteCarloResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if p.Samples < 2 || p.Samples > monteCarloMaxEvaluations {
+		return models.MonteCarloResult{}, fmt.Errorf("samples must be between 2 and %d", monteCarloMaxEvaluations)
+	}
+
+	cells, samples := 1, p.Samples
+	if p.Strata > 1 {
+		if method != "monte-carlo" {
+			return models.MonteCarloResult{}, fmt.Errorf("strata only applies to the monte-carlo method")
+		}
+		for j := 0; j < d; j++ {
+			cells *= p.Strata
+			if 2*cells > p.Samples {
+				return models.MonteCarloResult{}, fmt.Errorf("samples must be at least 2 strata^%d so that every stratum gets two points", d)
+			}
+		}
+		samples = p.Samples / cells * cells
+	}
+	evaluations := samples
+	if method != "monte-carlo" {
+		evaluations *= qmcReplicates
+	}
+	if p.Antithetic {
+		evaluations *= 2
+	}
+	if evaluations > monteCarloMaxEvaluations {
+		return models.MonteCarloResult{}, fmt.Errorf("%d evaluations is more than %d, use fewer samples", evaluations, monteCarloMaxEvaluations)
+	}
+
+	volume := 1.0
+	for j := range lower {
+		volume *= upper[j] - lower[j]
+	}
+	scope := make(map[string]float64, len(allowed))
+	x := make([]float64, d)
+	f := func(u []float64) (float64, error) {
+		for j := range u {
+			x[j] = lower[j] + (upper[j]-lower[j])*u[j]
+			scope[names[j]] = x[j]
+			if j < len(aliases) {
+				scope[aliases[j]] = x[j]
+			}
+		}
+		value := expr.Evaluate(scope)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return 0, fmt.Errorf("function is not defined at %v", x)
+		}
+		return value, nil
+	}
+	sample := func(u []float64) (float64, error) {
+		value, err := f(u)
+		if err != nil || !p.Antithetic {
+			return value, err
+		}
+		mirror := make([]float64, d)
+		for j := range u {
+			mirror[j] = 1 - u[j]
+		}
+		other, err := f(mirror)
+		return (value + other) / 2, err
+	}
+
+	rng := rand.New(rand.NewSource(p.Seed))
+	result := models.MonteCarloResult{Method: method, Dimension: d, Samples: samples, Evaluations: evaluations, Strata: 1}
+	record := func(n int, estimate, standardError float64) {
+		result.Convergence = append(result.Convergence, models.MonteCarloPoint{Samples: n, Estimate: estimate, StandardError: standardError})
+		result.Estimate, result.StandardError = estimate, standardError
+	}
+
+	switch {
+	case method != "monte-carlo":
+		result.Replicates = qmcReplicates
+		// estimates[r] holds replicate r's estimate at each checkpoint.
+		estimates := make([][]float64, qmcReplicates)
+		var checkpoints []int
+		for r := range estimates {
+			next := sobolSequence
+			if method == "halton" {
+				next = haltonSequence
+			}
+			sequence := next(d)
+			shift := make([]float64, d)
+			for j := range shift {
+				shift[j] = rng.Float64()
+			}
+			sum := 0.0
+			for n := 1; n <= samples; n++ {
+				u := sequence()
+				for j := range u {
+					u[j] += shift[j]
+					if u[j] >= 1 {
+						u[j]--
+					}
+				}
+				value, err := sample(u)
+				if err != nil {
+					return models.MonteCarloResult{}, err
+				}
+				sum += value
+				if monteCarloCheckpoint(n, samples) {
+					estimates[r] = append(estimates[r], volume*sum/float64(n))
+					if r == 0 {
+						checkpoints = append(checkpoints, n)
+					}
+				}
+			}
+		}
+		for k, n := range checkpoints {
+			var w welford
+			for r := range estimates {
+				w.add(estimates[r][k])
+			}
+			record(n, w.mean, math.Sqrt(w.variance()/qmcReplicates))
+		}
+	case cells > 1:
+		// Each round puts one point in every cell; the estimate is the mean
+		// of the cell means and its variance the sum of theirs over cells^2.
+		result.Strata = p.Strata
+		stats := make([]welford, cells)
+		u := make([]float64, d)
+		rounds := samples / cells
+		for round := 1; round <= rounds; round++ {
+			for c := range stats {
+				for j, index := 0, c; j < d; j, index = j+1, index/p.Strata {
+					u[j] = (float64(index%p.Strata) + rng.Float64()) / float64(p.Strata)
+				}
+				value, err := sample(u)
+				if err != nil {
+					return models.MonteCarloResult{}, err
+				}
+				stats[c].add(value)
+			}
+			if monteCarloCheckpoint(round, rounds) {
+				mean, variance := 0.0, 0.0
+				for _, s := range stats {
+					mean += s.mean
+					variance += s.variance() / float64(s.n)
+				}
+				c := float64(cells)
+				record(round*cells, volume*mean/c, math.Abs(volume)*math.Sqrt(variance)/c)
+			}
+		}
+	default:
+		var w welford
+		u := make([]float64, d)
+		for n := 1; n <= samples; n++ {
+			for j := range u {
+				u[j] = rng.Float64()
+			}
+			value, err := sample(u)
+			if err != nil {
+				return models.MonteCarloResult{}, err
+			}
+			w.add(value)
+			if monteCarloCheckpoint(n, samples) {
+				record(n, volume*w.mean, math.Abs(volume)*math.Sqrt(w.variance()/float64(n)))
+			}
+		}
+	}
+	return result, nil
+}
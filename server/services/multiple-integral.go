@@ -0,0 +1,188 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	multipleMaxIntervals   = 1000
+	multipleMaxGaussPoints = 100
+	// multipleMaxEvaluations keeps a triple integral to a few seconds.
+	multipleMaxEvaluations = 10000000
+)
+
+// gaussLegendre returns the n point Gauss-Legendre nodes and weights on
+// [-1, 1], found by Newton's method on the Legendre polynomial P_n.
+func gaussLegendre(n int) ([]float64, []float64) {
+	nodes, weights := make([]float64, n), make([]float64, n)
+	for i := 0; i < (n+1)/2; i++ {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var derivative float64
+		for it := 0; it < 100; it++ {
+			// Recurrence (k+1) P_{k+1} = (2k+1) x P_k - k P_{k-1}.
+			p0, p1 := 1.0, x
+			for k := 1; k < n; k++ {
+				p0, p1 = p1, ((2*float64(k)+1)*x*p1-float64(k)*p0)/float64(k+1)
+			}
+			derivative = float64(n) * (x*p1 - p0) / (x*x - 1)
+			dx := p1 / derivative
+			x -= dx
+			if math.Abs(dx) <= 1e-15 {
+				break
+			}
+		}
+		nodes[i], nodes[n-1-i] = -x, x
+		weights[i] = 2 / ((1 - x*x) * derivative * derivative)
+		weights[n-1-i] = weights[i]
+	}
+	return nodes, weights
+}
+
+// quadratureRule returns the nodes and weights of the chosen rule on [a, b]:
+// the composite trapezoid or Simpson 1/3 rule with n intervals, or the
+// n point Gauss-Legendre rule.
+func quadratureRule(method string, a, b float64, n int) ([]float64, []float64) {
+	var nodes, weights []float64
+	switch method {
+	case "gauss":
+		x, w := gaussLegendre(n)
+		for i := range x {
+			nodes = append(nodes, (a+b)/2+(b-a)/2*x[i])
+			weights = append(weights, (b-a)/2*w[i])
+		}
+	default:
+		h := (b - a) / float64(n)
+		for i := 0; i <= n; i++ {
+			w := h
+			switch {
+			case i == 0 || i == n:
+				w = h / 2
+				if method == "simpson" {
+					w = h / 3
+				}
+			case method == "simpson" && i%2 == 1:
+				w = 4 * h / 3
+			case method == "simpson":
+				w = 2 * h / 3
+			}
+			nodes = append(nodes, a+float64(i)*h)
+			weights = append(weights, w)
+		}
+	}
+	return nodes, weights
+}
+
+// solveMultipleIntegral evaluates the iterated integral
+//
+//	int_{x=a}^{b} int_{y=g1(x)}^{g2(x)} [int_{z=h1(x,y)}^{h2(x,y)}] f dz dy dx
+//
+// applying the same rule in every dimension with that dimension's interval
+// count. The z limits are left empty for a double integral.
+func solveMultipleIntegral(p models.MultipleIntegral) (models.MultipleIntegralResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "simpson"
+	}
+	if method != "trapezoid" && method != "simpson" && method != "gauss" {
+		return models.MultipleIntegralResult{}, fmt.Errorf("method must be trapezoid, simpson or gauss")
+	}
+	dimension := 2
+	variables := []string{"x", "y"}
+	if p.ZLower != "" || p.ZUpper != "" {
+		dimension = 3
+		variables = append(variables, "z")
+	}
+
+	f, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.MultipleIntegralResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := f.CheckVariables(variables...); err != nil {
+		return models.MultipleIntegralResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	// limit parses a limit of the given variable, which may use the outer
+	// variables only.
+	limit := func(name, input string, outer ...string) (*utils.Expression, error) {
+		if input == "" {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+		e, err := utils.ParseExpression(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		if err := e.CheckVariables(outer...); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		return e, nil
+	}
+	limits := make([][2]*utils.Expression, dimension)
+	for i, names := range [][2]string{{"y_lower", "y_upper"}, {"z_lower", "z_upper"}}[:dimension-1] {
+		inputs := [][2]string{{p.YLower, p.YUpper}, {p.ZLower, p.ZUpper}}[i]
+		for j := range names {
+			if limits[i+1][j], err = limit(names[j], inputs[j], variables[:i+1]...); err != nil {
+				return models.MultipleIntegralResult{}, err
+			}
+		}
+	}
+
+	intervals := []int{p.XInterval, p.YInterval, p.ZInterval}[:dimension]
+	evaluations := 1
+	for i, n := range intervals {
+		switch {
+		case method == "gauss" && (n < 1 || n > multipleMaxGaussPoints):
+			return models.MultipleIntegralResult{}, fmt.Errorf("%s_interval must be between 1 and %d Gauss points", variables[i], multipleMaxGaussPoints)
+		case method != "gauss" && (n < 1 || n > multipleMaxIntervals):
+			return models.MultipleIntegralResult{}, fmt.Errorf("%s_interval must be between 1 and %d", variables[i], multipleMaxIntervals)
+		case method == "simpson" && n%2 != 0:
+			return models.MultipleIntegralResult{}, fmt.Errorf("%s_interval must be even for Simpson's rule", variables[i])
+		}
+		if method == "gauss" {
+			evaluations *= n
+		} else {
+			evaluations *= n + 1
+		}
+	}
+	if evaluations > multipleMaxEvaluations {
+		return models.MultipleIntegralResult{}, fmt.Errorf("the rule needs %d evaluations, more than %d", evaluations, multipleMaxEvaluations)
+	}
+
+	result := models.MultipleIntegralResult{Dimension: dimension, Method: method}
+	scope := map[string]float64{}
+	var integrate func(level int, a, b float64) (float64, error)
+	integrate = func(level int, a, b float64) (float64, error) {
+		nodes, weights := quadratureRule(method, a, b, intervals[level])
+		sum := 0.0
+		for i, node := range nodes {
+			scope[variables[level]] = node
+			var value float64
+			if level == dimension-1 {
+				value = f.Evaluate(scope)
+				result.Evaluations++
+			} else {
+				lower := limits[level+1][0].Evaluate(scope)
+				upper := limits[level+1][1].Evaluate(scope)
+				if math.IsNaN(lower) || math.IsInf(lower, 0) || math.IsNaN(upper) || math.IsInf(upper, 0) {
+					return 0, fmt.Errorf("the %s limits are not defined at %s = %v", variables[level+1], variables[level], node)
+				}
+				var err error
+				if value, err = integrate(level+1, lower, upper); err != nil {
+					return 0, err
+				}
+			}
+			sum += weights[i] * value
+		}
+		return sum, nil
+	}
+	if result.Value, err = integrate(0, p.XLower, p.XUpper); err != nil {
+		return models.MultipleIntegralResult{}, err
+	}
+	if math.IsNaN(result.Value) || math.IsInf(result.Value, 0) {
+		return models.MultipleIntegralResult{}, fmt.Errorf("the integral is not finite")
+	}
+	return result, nil
+}
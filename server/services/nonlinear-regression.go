@@ -0,0 +1,252 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	nonlinearMaxIterations = 200
+	nonlinearDefaultE      = 1e-8
+)
+
+// solveNonlinearRegression fits a model that is nonlinear in its parameters,
+// e.g. "a*exp(b*x)+c", to the points with the Levenberg–Marquardt method.
+func solveNonlinearRegression(p models.NonlinearRegression) (models.NonlinearRegressionResult, error) {
+	xs, ys, err := utils.ParsePoints(p.Points)
+	if err != nil {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("invalid points: %v", err)
+	}
+	expr, err := utils.ParseExpression(p.Model)
+	if err != nil {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("invalid model: %v", err)
+	}
+
+	var names []string
+	if strings.TrimSpace(p.Parameters) != "" {
+		for _, name := range strings.Split(p.Parameters, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	} else {
+		for _, name := range expr.Variables() {
+			if name != "x" {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	if len(names) == 0 {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("model has no parameters")
+	}
+	if err := expr.CheckVariables(append([]string{"x"}, names...)...); err != nil {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("invalid model: %v", err)
+	}
+	if len(xs) <= len(names) {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("at least %d points are needed to fit %d parameters", len(names)+1, len(names))
+	}
+
+	beta := make([]float64, len(names))
+	for i := range beta {
+		beta[i] = 1
+	}
+	if strings.TrimSpace(p.Initial) != "" {
+		initial, err := utils.ParseFloatList(p.Initial)
+		if err != nil {
+			return models.NonlinearRegressionResult{}, fmt.Errorf("invalid initial: %v", err)
+		}
+		if len(initial) != len(names) {
+			return models.NonlinearRegressionResult{}, fmt.Errorf("initial must have %d values, one for each of %s", len(names), strings.Join(names, ", "))
+		}
+		beta = initial
+	}
+	e := p.E
+	if e <= 0 {
+		e = nonlinearDefaultE
+	}
+
+	model := func(x float64, beta []float64) float64 {
+		scope := map[string]float64{"x": x}
+		for i, name := range names {
+			scope[name] = beta[i]
+		}
+		return expr.Evaluate(scope)
+	}
+	residuals := func(beta []float64) ([]float64, float64) {
+		r := make([]float64, len(xs))
+		ssr := 0.0
+		for i := range xs {
+			r[i] = ys[i] - model(xs[i], beta)
+			ssr += r[i] * r[i]
+		}
+		return r, ssr
+	}
+	// jacobian of the model with respect to the parameters, by central differences.
+	jacobian := func(beta []float64) [][]float64 {
+		J := make([][]float64, len(xs))
+		shifted := append([]float64(nil), beta...)
+		for i := range xs {
+			J[i] = make([]float64, len(beta))
+		}
+		for j := range beta {
+			h := math.Cbrt(machineEpsilon) * math.Max(1, math.Abs(beta[j]))
+			for i := range xs {
+				shifted[j] = beta[j] + h
+				forward := model(xs[i], shifted)
+				shifted[j] = beta[j] - h
+				backward := model(xs[i], shifted)
+				J[i][j] = (forward - backward) / (2 * h)
+			}
+			shifted[j] = beta[j]
+		}
+		return J
+	}
+
+	r, ssr := residuals(beta)
+	if math.IsNaN(ssr) || math.IsInf(ssr, 0) {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("model is not defined at the initial parameters")
+	}
+
+	lambda := 1e-3
+	result := models.NonlinearRegressionResult{}
+	result.Iterations = append(result.Iterations, models.NonlinearIteration{
+		Iteration:           0,
+		Parameters:          append([]float64(nil), beta...),
+		SumSquaredResiduals: ssr,
+		Lambda:              lambda,
+	})
+
+	for it := 1; it <= nonlinearMaxIterations; it++ {
+		J := jacobian(beta)
+		n := len(beta)
+		A := make([][]float64, n)
+		g := make([]float64, n)
+		for a := 0; a < n; a++ {
+			A[a] = make([]float64, n)
+			for b := 0; b < n; b++ {
+				for i := range J {
+					A[a][b] += J[i][a] * J[i][b]
+				}
+			}
+			for i := range J {
+				g[a] += J[i][a] * r[i]
+			}
+		}
+
+		// Raise lambda until the damped step reduces the sum of squares.
+		accepted := false
+		var step []float64
+		for !accepted && lambda < 1e16 {
+			damped := make([][]float64, n)
+			for a := range A {
+				damped[a] = append([]float64(nil), A[a]...)
+				damped[a][a] += lambda * math.Max(A[a][a], 1e-12)
+			}
+			step, err = solveLinearSystem(damped, g)
+			if err != nil {
+				lambda *= 10
+				continue
+			}
+			trial := make([]float64, n)
+			for j := range beta {
+				trial[j] = beta[j] + step[j]
+			}
+			rTrial, ssrTrial := residuals(trial)
+			if !math.IsNaN(ssrTrial) && ssrTrial < ssr {
+				accepted = true
+				beta, r = trial, rTrial
+				reduction := ssr - ssrTrial
+				ssr = ssrTrial
+				lambda = math.Max(lambda/10, 1e-12)
+				result.Iterations = append(result.Iterations, models.NonlinearIteration{
+					Iteration:           it,
+					Parameters:          append([]float64(nil), beta...),
+					SumSquaredResiduals: ssr,
+					Lambda:              lambda,
+				})
+				if vectorNorm(step) <= e*(vectorNorm(beta)+e) {
+					result.Converged, result.Reason = true, "the step is within e of the parameters"
+				} else if reduction <= e*ssr {
+					result.Converged, result.Reason = true, "the sum of squares changed by less than e"
+				}
+			} else {
+				lambda *= 10
+			}
+		}
+		if !accepted {
+			// No step reduces the residual. That is a (local) minimum only if
+			// the residuals are orthogonal to the columns of J, i.e. the
+			// gradient J^T r is small next to |J| |r|.
+			normJ := 0.0
+			for i := range J {
+				normJ += vectorDot(J[i], J[i])
+			}
+			gradient := vectorNorm(g)
+			switch {
+			case ssr == 0 || gradient <= math.Sqrt(e)*math.Sqrt(normJ*ssr):
+				result.Converged, result.Reason = true, "no step reduces the sum of squares and the gradient vanishes"
+			case math.IsNaN(gradient) || math.IsInf(gradient, 0):
+				result.Reason = "no step reduces the sum of squares and the gradient is not defined, so the fit stalled"
+			default:
+				result.Reason = fmt.Sprintf("no step reduces the sum of squares but the gradient norm is %g, so the fit stalled", gradient)
+			}
+			break
+		}
+		if result.Converged {
+			break
+		}
+	}
+	if !result.Converged && result.Reason == "" {
+		result.Reason = fmt.Sprintf("no convergence after %d iterations", nonlinearMaxIterations)
+	}
+
+	// Covariance s^2 (J^T J)^-1 with s^2 = SSR / (m - p).
+	J := jacobian(beta)
+	n := len(beta)
+	A := make([][]float64, n)
+	for a := 0; a < n; a++ {
+		A[a] = make([]float64, n)
+		for b := 0; b < n; b++ {
+			for i := range J {
+				A[a][b] += J[i][a] * J[i][b]
+			}
+		}
+	}
+	variance := ssr / float64(len(xs)-n)
+	covariance, err := invertMatrix(A)
+	if err != nil {
+		// The fit itself is still usable; only its uncertainty is unknown.
+		covariance = nil
+		result.Warnings = append(result.Warnings, fmt.Sprintf("J^T J is singular, so the parameters are not identifiable from the points and have no covariance: %v", err))
+	}
+	for a := range covariance {
+		for b := range covariance[a] {
+			covariance[a][b] *= variance
+		}
+	}
+
+	for j, name := range names {
+		parameter := models.RegressionParameter{Name: name, Value: beta[j]}
+		if covariance != nil {
+			if v := covariance[j][j]; v >= 0 {
+				standardError := math.Sqrt(v)
+				parameter.StandardError = &standardError
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("the variance of %s is %g, which is negative, so J^T J is too ill-conditioned for a standard error", name, v))
+			}
+		}
+		result.Parameters = append(result.Parameters, parameter)
+	}
+	result.Covariance = covariance
+	result.Residuals = r
+	result.SumSquaredResiduals = ssr
+	result.Prediction = model(p.Xvalue, beta)
+	if math.IsNaN(result.Prediction) || math.IsInf(result.Prediction, 0) {
+		return models.NonlinearRegressionResult{}, fmt.Errorf("model is not defined at xvalue")
+	}
+	return result, nil
+}
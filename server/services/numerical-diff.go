@@ -17,6 +17,8 @@ type NumericalDiffServiceImpl struct {
 type NumericalDiffService interface {
 	GetNumericalDiff(c *fiber.Ctx) error
 	CreateNumericalDiff(c *fiber.Ctx) error
+	GetSymbolicDiff(c *fiber.Ctx) error
+	CreateSymbolicDiff(c *fiber.Ctx) error
 }
 
 func NewNumericalDiffService(db *gorm.DB) NumericalDiffService {
@@ -25,7 +27,7 @@ func NewNumericalDiffService(db *gorm.DB) NumericalDiffService {
 
 // @Tags numerical-diff
 // @Summary Get numerical diff
-// @Description Get numerical diff by id
+// @Description Get numerical diff by id, with the result or, when the input cannot be solved, the error
 // @Accept json
 // @Produce json
 // @Param id path string true "Numerical diff id"
@@ -50,12 +52,14 @@ func (s *NumericalDiffServiceImpl) GetNumericalDiff(c *fiber.Ctx) error {
 		})
 	}
 
+	attachNumericalDiff(&numericalDiff)
+
 	return c.Status(fiber.StatusOK).JSON(numericalDiff)
 }
 
 // @Tags numerical-diff
 // @Summary Create numerical diff
-// @Description Create numerical diff
+// @Description Differentiate the function at x with automatic differentiation (dual numbers for order 1, hyper-dual numbers for order 2, Taylor series above) and compare the forward, backward, central and complex-step estimates against it; an input that cannot be solved is still stored, with error set instead of result
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqNumericalDiff true "Request Body"
@@ -84,6 +88,103 @@ func (s *NumericalDiffServiceImpl) CreateNumericalDiff(c *fiber.Ctx) error {
 			Message: "failed to create numerical diff",
 		})
 	}
+	attachNumericalDiff(&numericalDiff)
 
 	return c.Status(fiber.StatusOK).JSON(numericalDiff)
 }
+
+// attachNumericalDiff solves the stored input and sets its result, or the
+// reason it cannot be solved. The row is returned either way, as it was
+// before the result was added, since the client pages only need the input.
+func attachNumericalDiff(numericalDiff *models.NumericalDiff) {
+	result, err := solveNumericalDiff(*numericalDiff)
+	if err != nil {
+		numericalDiff.Error = err.Error()
+		return
+	}
+	numericalDiff.Result = &result
+}
+
+// @Tags numerical-diff
+// @Summary Get symbolic diff
+// @Description Get the symbolic differentiation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "numerical-diff ID"
+// @Success 200 {object} models.SymbolicDiff
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/numerical-diff/symbolic/{id} [get]
+func (s *NumericalDiffServiceImpl) GetSymbolicDiff(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var symbolicDiff models.SymbolicDiff
+
+	if err := s.DB.First(&symbolicDiff, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Symbolic diff data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching symbolic diff data",
+		})
+	}
+
+	result, err := solveSymbolicDiff(symbolicDiff)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	symbolicDiff.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(symbolicDiff)
+}
+
+// @Tags numerical-diff
+// @Summary Create symbolic diff
+// @Description Differentiate the function symbolically up to the given order and return each simplified derivative with its value at x; if an order grows too long, the lower orders are returned with a warning
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqSymbolicDiff true "Request Body"
+// @Success 201 {object} models.SymbolicDiff
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/numerical-diff/symbolic [post]
+func (s *NumericalDiffServiceImpl) CreateSymbolicDiff(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqSymbolicDiff)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	symbolicDiff := models.SymbolicDiff{
+		Function: req.Function,
+		X:        req.X,
+		Order:    req.Order,
+	}
+
+	result, err := solveSymbolicDiff(symbolicDiff)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&symbolicDiff).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	symbolicDiff.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(symbolicDiff)
+}
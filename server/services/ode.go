@@ -0,0 +1,116 @@
+package services
+
+import (
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ODEServiceImpl struct {
+	DB *gorm.DB
+}
+
+type ODEService interface {
+	GetBVP(c *fiber.Ctx) error
+	CreateBVP(c *fiber.Ctx) error
+}
+
+func NewODEService(db *gorm.DB) ODEService {
+	return &ODEServiceImpl{
+		DB: db,
+	}
+}
+
+// @Tags Boundary Value Problem
+// @Summary Get Boundary Value Problem Result
+// @Description Get the boundary value problem result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Boundary Value Problem ID"
+// @Success 200 {object} models.BVP
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/ode/bvp/{id} [get]
+func (s *ODEServiceImpl) GetBVP(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var bvp models.BVP
+
+	if err := s.DB.First(&bvp, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Boundary value problem data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching boundary value problem data",
+		})
+	}
+
+	result, err := solveBVP(bvp)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	bvp.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(bvp)
+}
+
+// @Tags Boundary Value Problem
+// @Summary Create Boundary Value Problem Result
+// @Description Solve d^2y/dx^2 = f(x, y, y') with y(a) = alpha and y(b) = beta by the shooting method or finite differences and return the solution table and iteration log
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBVP true "Request Body"
+// @Success 201 {object} models.BVP
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/ode/bvp [post]
+func (s *ODEServiceImpl) CreateBVP(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBVP)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	bvp := models.BVP{
+		Function:  req.Function,
+		Lower:     req.Lower,
+		Upper:     req.Upper,
+		Alpha:     req.Alpha,
+		Beta:      req.Beta,
+		Intervals: req.Intervals,
+		Method:    req.Method,
+		Slope0:    req.Slope0,
+		Slope1:    req.Slope1,
+		E:         req.E,
+		Exact:     req.Exact,
+	}
+
+	result, err := solveBVP(bvp)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&bvp).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	bvp.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(bvp)
+}
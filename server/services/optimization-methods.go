@@ -0,0 +1,507 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const optimizationMaxIterations = 1000
+
+// objective evaluates an equation over named variables. Maximization is
+// handled by minimizing -f, so every method only needs to minimize.
+type objective struct {
+	expr        *utils.Expression
+	variables   []string
+	sign        float64
+	evaluations int
+	undefined   bool
+}
+
+func newObjective(equation string, variables []string, goal string) (*objective, error) {
+	expr, err := utils.ParseExpression(equation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	if err := expr.CheckVariables(variables...); err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	sign := 1.0
+	switch strings.ToLower(goal) {
+	case "", "min":
+	case "max":
+		sign = -1
+	default:
+		return nil, fmt.Errorf("goal must be min or max")
+	}
+	return &objective{expr: expr, variables: variables, sign: sign}, nil
+}
+
+// value is f(x) itself, without counting it as an evaluation.
+func (o *objective) value(x []float64) float64 {
+	scope := make(map[string]float64, len(x))
+	for i, name := range o.variables {
+		scope[name] = x[i]
+	}
+	return o.expr.Evaluate(scope)
+}
+
+// eval is the counted, sign-adjusted function that the methods minimize.
+func (o *objective) eval(x []float64) float64 {
+	o.evaluations++
+	y := o.sign * o.value(x)
+	if math.IsNaN(y) || math.IsInf(y, 0) {
+		o.undefined = true
+	}
+	return y
+}
+
+// gradient approximates the gradient of the minimized function with central
+// differences; the evaluations are counted.
+func (o *objective) gradient(x []float64) []float64 {
+	g := make([]float64, len(x))
+	point := append([]float64(nil), x...)
+	for i := range x {
+		h := math.Cbrt(machineEpsilon) * math.Max(1, math.Abs(x[i]))
+		point[i] = x[i] + h
+		forward := o.eval(point)
+		point[i] = x[i] - h
+		backward := o.eval(point)
+		point[i] = x[i]
+		g[i] = (forward - backward) / (2 * h)
+	}
+	return g
+}
+
+// gradientNorm reports |grad f(x)| for the iteration log without counting
+// the evaluations against the method.
+func (o *objective) gradientNorm(x []float64) float64 {
+	evaluations, undefined := o.evaluations, o.undefined
+	norm := vectorNorm(o.gradient(x))
+	o.evaluations, o.undefined = evaluations, undefined
+	if math.IsNaN(norm) || math.IsInf(norm, 0) {
+		return 0
+	}
+	return norm
+}
+
+func (o *objective) iteration(i int, x []float64) models.OptimizationIteration {
+	return models.OptimizationIteration{
+		Iteration:    i,
+		X:            append([]float64(nil), x...),
+		Fx:           o.value(x),
+		GradientNorm: o.gradientNorm(x),
+	}
+}
+
+func (o *objective) result(x []float64, converged bool, iterations []models.OptimizationIteration) (models.OptimizationResult, error) {
+	if o.undefined {
+		return models.OptimizationResult{}, fmt.Errorf("equation is not defined at some iterate")
+	}
+	return models.OptimizationResult{
+		Variables:           o.variables,
+		X:                   x,
+		Fx:                  o.value(x),
+		Converged:           converged,
+		FunctionEvaluations: o.evaluations,
+		Iterations:          iterations,
+	}, nil
+}
+
+// newInterval prepares a one dimensional problem in x on [xl, xr].
+func newInterval(equation string, xl, xr, e float64, goal string) (*objective, float64, float64, error) {
+	o, err := newObjective(equation, []string{"x"}, goal)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if e <= 0 {
+		return nil, 0, 0, fmt.Errorf("e must be greater than 0")
+	}
+	if xl == xr {
+		return nil, 0, 0, fmt.Errorf("xl and xr must be different")
+	}
+	xl, xr = sortedBracket(xl, xr)
+	return o, xl, xr, nil
+}
+
+// newStartingPoint prepares a multivariate problem from the variable list
+// (defaulting to the equation's variables in alphabetical order) and x0.
+func newStartingPoint(equation, variables, x0 string, e float64, goal string) (*objective, []float64, error) {
+	var names []string
+	if strings.TrimSpace(variables) == "" {
+		expr, err := utils.ParseExpression(equation)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid equation: %v", err)
+		}
+		names = expr.Variables()
+		sort.Strings(names)
+	} else {
+		for _, name := range strings.Split(variables, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("equation has no variables")
+	}
+	o, err := newObjective(equation, names, goal)
+	if err != nil {
+		return nil, nil, err
+	}
+	x, err := utils.ParseFloatList(x0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid x0: %v", err)
+	}
+	if len(x) != len(names) {
+		return nil, nil, fmt.Errorf("x0 must have %d values, one for each of %s", len(names), strings.Join(names, ", "))
+	}
+	if e <= 0 {
+		return nil, nil, fmt.Errorf("e must be greater than 0")
+	}
+	return o, x, nil
+}
+
+// solveGoldenSection shrinks [xl, xr] by the golden ratio, stopping when
+// (1-R)|(xu-xl)/xopt| * 100 is below e.
+func solveGoldenSection(p models.GoldenSection) (models.OptimizationResult, error) {
+	o, xl, xu, err := newInterval(p.Equation, p.Xl, p.Xr, p.E, p.Goal)
+	if err != nil {
+		return models.OptimizationResult{}, err
+	}
+
+	R := (math.Sqrt(5) - 1) / 2
+	d := R * (xu - xl)
+	x1, x2 := xl+d, xu-d
+	f1, f2 := o.eval([]float64{x1}), o.eval([]float64{x2})
+
+	var iterations []models.OptimizationIteration
+	xopt := x1
+	converged := false
+	for i := 1; i <= optimizationMaxIterations; i++ {
+		if f1 < f2 {
+			xl = x2
+			x2, f2 = x1, f1
+			x1 = xl + R*(xu-xl)
+			f1 = o.eval([]float64{x1})
+		} else {
+			xu = x1
+			x1, f1 = x2, f2
+			x2 = xu - R*(xu-xl)
+			f2 = o.eval([]float64{x2})
+		}
+		xopt = x2
+		if f1 < f2 {
+			xopt = x1
+		}
+		iterations = append(iterations, o.iteration(i, []float64{xopt}))
+		if xopt != 0 && (1-R)*math.Abs((xu-xl)/xopt)*100 <= p.E {
+			converged = true
+			break
+		}
+		if xopt == 0 && xu-xl <= p.E/100 {
+			converged = true
+			break
+		}
+	}
+	return o.result([]float64{xopt}, converged, iterations)
+}
+
+// solveParabolicInterpolation fits a parabola through three points and moves
+// to its vertex, falling back to a golden-section step when the vertex leaves
+// the bracket.
+func solveParabolicInterpolation(p models.ParabolicInterpolation) (models.OptimizationResult, error) {
+	o, x0, x2, err := newInterval(p.Equation, p.Xl, p.Xr, p.E, p.Goal)
+	if err != nil {
+		return models.OptimizationResult{}, err
+	}
+
+	x1 := 0.5 * (x0 + x2)
+	f0, f1, f2 := o.eval([]float64{x0}), o.eval([]float64{x1}), o.eval([]float64{x2})
+
+	var iterations []models.OptimizationIteration
+	converged := false
+	for i := 1; i <= optimizationMaxIterations; i++ {
+		numerator := f0*(x1*x1-x2*x2) + f1*(x2*x2-x0*x0) + f2*(x0*x0-x1*x1)
+		denominator := 2*f0*(x1-x2) + 2*f1*(x2-x0) + 2*f2*(x0-x1)
+		x3 := numerator / denominator
+		if denominator == 0 || !(x3 > x0 && x3 < x2) {
+			// Golden-section step into the larger part of the bracket.
+			const C = 0.3819660112501051
+			if x2-x1 > x1-x0 {
+				x3 = x1 + C*(x2-x1)
+			} else {
+				x3 = x1 - C*(x1-x0)
+			}
+		}
+		f3 := o.eval([]float64{x3})
+
+		xOld := x1
+		if x3 > x1 {
+			if f3 < f1 {
+				x0, f0 = x1, f1
+				x1, f1 = x3, f3
+			} else {
+				x2, f2 = x3, f3
+			}
+		} else {
+			if f3 < f1 {
+				x2, f2 = x1, f1
+				x1, f1 = x3, f3
+			} else {
+				x0, f0 = x3, f3
+			}
+		}
+
+		iterations = append(iterations, o.iteration(i, []float64{x1}))
+		if relativeError(xOld, x3) <= p.E || x2-x0 <= machineEpsilon*math.Max(1, math.Abs(x1)) {
+			converged = true
+			break
+		}
+	}
+	return o.result([]float64{x1}, converged, iterations)
+}
+
+// lineSearch backtracks from a unit step until the Armijo sufficient decrease
+// condition holds along the descent direction.
+func lineSearch(o *objective, x []float64, fx float64, g, direction []float64) ([]float64, float64) {
+	slope := vectorDot(g, direction)
+	t := 1.0
+	next := make([]float64, len(x))
+	for k := 0; k < 60; k++ {
+		for i := range x {
+			next[i] = x[i] + t*direction[i]
+		}
+		fNext := o.eval(next)
+		if fNext <= fx+1e-4*t*slope {
+			return next, fNext
+		}
+		t *= 0.5
+	}
+	return x, fx
+}
+
+// solveGradientDescent follows -grad f with a fixed step, or with a
+// backtracking line search when step is 0, until |grad f| is below e.
+func solveGradientDescent(p models.GradientDescent) (models.OptimizationResult, error) {
+	o, x, err := newStartingPoint(p.Equation, p.Variables, p.X0, p.E, p.Goal)
+	if err != nil {
+		return models.OptimizationResult{}, err
+	}
+	if p.Step < 0 {
+		return models.OptimizationResult{}, fmt.Errorf("step must not be negative")
+	}
+
+	fx := o.eval(x)
+	iterations := []models.OptimizationIteration{o.iteration(0, x)}
+	converged := false
+	for i := 1; i <= optimizationMaxIterations; i++ {
+		g := o.gradient(x)
+		if vectorNorm(g) <= p.E {
+			converged = true
+			break
+		}
+		direction := vectorScaled(g, -1)
+		if p.Step > 0 {
+			for j := range x {
+				x[j] += p.Step * direction[j]
+			}
+			fx = o.eval(x)
+		} else {
+			next, fNext := lineSearch(o, x, fx, g, direction)
+			if fNext >= fx {
+				break
+			}
+			x, fx = next, fNext
+		}
+		iterations = append(iterations, o.iteration(i, x))
+		if o.undefined {
+			break
+		}
+	}
+	return o.result(x, converged, iterations)
+}
+
+// solveNelderMead runs the downhill simplex method, stopping when the
+// function values and the vertices of the simplex agree to within e.
+func solveNelderMead(p models.NelderMead) (models.OptimizationResult, error) {
+	o, x, err := newStartingPoint(p.Equation, p.Variables, p.X0, p.E, p.Goal)
+	if err != nil {
+		return models.OptimizationResult{}, err
+	}
+
+	const (
+		alpha = 1.0
+		gamma = 2.0
+		rho   = 0.5
+		sigma = 0.5
+	)
+	n := len(x)
+	simplex := make([][]float64, n+1)
+	values := make([]float64, n+1)
+	simplex[0] = append([]float64(nil), x...)
+	for i := 0; i < n; i++ {
+		vertex := append([]float64(nil), x...)
+		if vertex[i] != 0 {
+			vertex[i] *= 1.05
+		} else {
+			vertex[i] = 0.00025
+		}
+		simplex[i+1] = vertex
+	}
+	for i := range simplex {
+		values[i] = o.eval(simplex[i])
+	}
+
+	point := func(base, towards []float64, t float64) []float64 {
+		result := make([]float64, n)
+		for i := range result {
+			result[i] = base[i] + t*(towards[i]-base[i])
+		}
+		return result
+	}
+
+	var iterations []models.OptimizationIteration
+	converged := false
+	for it := 1; it <= optimizationMaxIterations; it++ {
+		order := make([]int, n+1)
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool { return values[order[a]] < values[order[b]] })
+		sortedSimplex := make([][]float64, n+1)
+		sortedValues := make([]float64, n+1)
+		for i, k := range order {
+			sortedSimplex[i], sortedValues[i] = simplex[k], values[k]
+		}
+		simplex, values = sortedSimplex, sortedValues
+
+		iterations = append(iterations, o.iteration(it, simplex[0]))
+
+		spread, size := 0.0, 0.0
+		for i := 1; i <= n; i++ {
+			spread = math.Max(spread, math.Abs(values[i]-values[0]))
+			for j := 0; j < n; j++ {
+				size = math.Max(size, math.Abs(simplex[i][j]-simplex[0][j]))
+			}
+		}
+		if spread <= p.E && size <= p.E {
+			converged = true
+			break
+		}
+
+		centroid := make([]float64, n)
+		for i := 0; i < n; i++ {
+			for j := range centroid {
+				centroid[j] += simplex[i][j] / float64(n)
+			}
+		}
+
+		worst := simplex[n]
+		reflected := point(centroid, worst, -alpha)
+		fr := o.eval(reflected)
+		switch {
+		case fr < values[0]:
+			expanded := point(centroid, reflected, gamma)
+			if fe := o.eval(expanded); fe < fr {
+				simplex[n], values[n] = expanded, fe
+			} else {
+				simplex[n], values[n] = reflected, fr
+			}
+		case fr < values[n-1]:
+			simplex[n], values[n] = reflected, fr
+		default:
+			var contracted []float64
+			var fc float64
+			accepted := false
+			if fr < values[n] {
+				contracted = point(centroid, reflected, rho)
+				fc = o.eval(contracted)
+				accepted = fc <= fr
+			} else {
+				contracted = point(centroid, worst, rho)
+				fc = o.eval(contracted)
+				accepted = fc < values[n]
+			}
+			if accepted {
+				simplex[n], values[n] = contracted, fc
+			} else {
+				for i := 1; i <= n; i++ {
+					simplex[i] = point(simplex[0], simplex[i], sigma)
+					values[i] = o.eval(simplex[i])
+				}
+			}
+		}
+		if o.undefined {
+			break
+		}
+	}
+
+	best := 0
+	for i := range values {
+		if values[i] < values[best] {
+			best = i
+		}
+	}
+	return o.result(simplex[best], converged, iterations)
+}
+
+// solveBFGS is a quasi-Newton method that builds an inverse Hessian
+// approximation from successive gradients, stopping when |grad f| < e.
+func solveBFGS(p models.BFGS) (models.OptimizationResult, error) {
+	o, x, err := newStartingPoint(p.Equation, p.Variables, p.X0, p.E, p.Goal)
+	if err != nil {
+		return models.OptimizationResult{}, err
+	}
+
+	n := len(x)
+	H := identityMatrix(n)
+	fx := o.eval(x)
+	g := o.gradient(x)
+	iterations := []models.OptimizationIteration{o.iteration(0, x)}
+	converged := false
+	for it := 1; it <= optimizationMaxIterations; it++ {
+		if vectorNorm(g) <= p.E {
+			converged = true
+			break
+		}
+		direction := vectorScaled(matrixVector(H, g), -1)
+		if vectorDot(direction, g) >= 0 {
+			// Not a descent direction any more: restart from steepest descent.
+			H = identityMatrix(n)
+			direction = vectorScaled(g, -1)
+		}
+		next, fNext := lineSearch(o, x, fx, g, direction)
+		if fNext >= fx {
+			break
+		}
+		gNext := o.gradient(next)
+
+		s := make([]float64, n)
+		y := make([]float64, n)
+		for i := range s {
+			s[i] = next[i] - x[i]
+			y[i] = gNext[i] - g[i]
+		}
+		if sy := vectorDot(s, y); sy > 1e-12 {
+			// H = (I - rho s y^T) H (I - rho y s^T) + rho s s^T
+			rho := 1 / sy
+			Hy := matrixVector(H, y)
+			yHy := vectorDot(y, Hy)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					H[i][j] += -rho*(Hy[i]*s[j]+s[i]*Hy[j]) + (rho*rho*yHy+rho)*s[i]*s[j]
+				}
+			}
+		}
+
+		x, fx, g = next, fNext, gNext
+		iterations = append(iterations, o.iteration(it, x))
+		if o.undefined {
+			break
+		}
+	}
+	return o.result(x, converged, iterations)
+}
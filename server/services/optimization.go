@@ -0,0 +1,463 @@
+package services
+
+import (
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type OptimizationServiceImpl struct {
+	DB *gorm.DB
+}
+
+type OptimizationService interface {
+	GetGoldenSection(c *fiber.Ctx) error
+	CreateGoldenSection(c *fiber.Ctx) error
+	GetParabolicInterpolation(c *fiber.Ctx) error
+	CreateParabolicInterpolation(c *fiber.Ctx) error
+	GetGradientDescent(c *fiber.Ctx) error
+	CreateGradientDescent(c *fiber.Ctx) error
+	GetNelderMead(c *fiber.Ctx) error
+	CreateNelderMead(c *fiber.Ctx) error
+	GetBFGS(c *fiber.Ctx) error
+	CreateBFGS(c *fiber.Ctx) error
+}
+
+func NewOptimizationService(db *gorm.DB) OptimizationService {
+	return &OptimizationServiceImpl{
+		DB: db,
+	}
+}
+
+// @Tags Golden Section
+// @Summary Get Golden Section Result
+// @Description Get the golden section result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "GoldenSection ID"
+// @Success 200 {object} models.GoldenSection
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/optimization/golden-section/{id} [get]
+func (s *OptimizationServiceImpl) GetGoldenSection(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var goldenSection models.GoldenSection
+
+	if err := s.DB.First(&goldenSection, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Golden section data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching golden section data",
+		})
+	}
+
+	result, err := solveGoldenSection(goldenSection)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	goldenSection.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(goldenSection)
+}
+
+// @Tags Golden Section
+// @Summary Create Golden Section Result
+// @Description Find the minimum (or maximum with goal "max") of f(x) on [xl, xr] by golden-section search
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqGoldenSection true "Request Body"
+// @Success 201 {object} models.GoldenSection
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/optimization/golden-section [post]
+func (s *OptimizationServiceImpl) CreateGoldenSection(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqGoldenSection)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	goldenSection := models.GoldenSection{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+		Goal:     req.Goal,
+	}
+
+	result, err := solveGoldenSection(goldenSection)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&goldenSection).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	goldenSection.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(goldenSection)
+}
+
+// @Tags Parabolic Interpolation
+// @Summary Get Parabolic Interpolation Result
+// @Description Get the parabolic interpolation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "ParabolicInterpolation ID"
+// @Success 200 {object} models.ParabolicInterpolation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/optimization/parabolic-interpolation/{id} [get]
+func (s *OptimizationServiceImpl) GetParabolicInterpolation(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var parabolicInterpolation models.ParabolicInterpolation
+
+	if err := s.DB.First(&parabolicInterpolation, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Parabolic interpolation data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching parabolic interpolation data",
+		})
+	}
+
+	result, err := solveParabolicInterpolation(parabolicInterpolation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	parabolicInterpolation.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(parabolicInterpolation)
+}
+
+// @Tags Parabolic Interpolation
+// @Summary Create Parabolic Interpolation Result
+// @Description Find the minimum (or maximum with goal "max") of f(x) on [xl, xr] by successive parabolic interpolation
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqParabolicInterpolation true "Request Body"
+// @Success 201 {object} models.ParabolicInterpolation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/optimization/parabolic-interpolation [post]
+func (s *OptimizationServiceImpl) CreateParabolicInterpolation(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqParabolicInterpolation)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	parabolicInterpolation := models.ParabolicInterpolation{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+		Goal:     req.Goal,
+	}
+
+	result, err := solveParabolicInterpolation(parabolicInterpolation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&parabolicInterpolation).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	parabolicInterpolation.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(parabolicInterpolation)
+}
+
+// @Tags Gradient Descent
+// @Summary Get Gradient Descent Result
+// @Description Get the gradient descent result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "GradientDescent ID"
+// @Success 200 {object} models.GradientDescent
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/optimization/gradient-descent/{id} [get]
+func (s *OptimizationServiceImpl) GetGradientDescent(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var gradientDescent models.GradientDescent
+
+	if err := s.DB.First(&gradientDescent, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Gradient descent data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching gradient descent data",
+		})
+	}
+
+	result, err := solveGradientDescent(gradientDescent)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	gradientDescent.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(gradientDescent)
+}
+
+// @Tags Gradient Descent
+// @Summary Create Gradient Descent Result
+// @Description Minimize (or maximize with goal "max") f from x0 by gradient descent with a fixed step, or a line search when step is 0
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqGradientDescent true "Request Body"
+// @Success 201 {object} models.GradientDescent
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/optimization/gradient-descent [post]
+func (s *OptimizationServiceImpl) CreateGradientDescent(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqGradientDescent)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	gradientDescent := models.GradientDescent{
+		Equation:  req.Equation,
+		Variables: req.Variables,
+		X0:        req.X0,
+		Step:      req.Step,
+		E:         req.E,
+		Goal:      req.Goal,
+	}
+
+	result, err := solveGradientDescent(gradientDescent)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&gradientDescent).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	gradientDescent.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(gradientDescent)
+}
+
+// @Tags Nelder Mead
+// @Summary Get Nelder Mead Result
+// @Description Get the nelder mead result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "NelderMead ID"
+// @Success 200 {object} models.NelderMead
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/optimization/nelder-mead/{id} [get]
+func (s *OptimizationServiceImpl) GetNelderMead(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var nelderMead models.NelderMead
+
+	if err := s.DB.First(&nelderMead, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Nelder Mead data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching Nelder Mead data",
+		})
+	}
+
+	result, err := solveNelderMead(nelderMead)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	nelderMead.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(nelderMead)
+}
+
+// @Tags Nelder Mead
+// @Summary Create Nelder Mead Result
+// @Description Minimize (or maximize with goal "max") f from x0 with the Nelder-Mead simplex method
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqNelderMead true "Request Body"
+// @Success 201 {object} models.NelderMead
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/optimization/nelder-mead [post]
+func (s *OptimizationServiceImpl) CreateNelderMead(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqNelderMead)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	nelderMead := models.NelderMead{
+		Equation:  req.Equation,
+		Variables: req.Variables,
+		X0:        req.X0,
+		E:         req.E,
+		Goal:      req.Goal,
+	}
+
+	result, err := solveNelderMead(nelderMead)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&nelderMead).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	nelderMead.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(nelderMead)
+}
+
+// @Tags BFGS
+// @Summary Get BFGS Result
+// @Description Get the BFGS result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "BFGS ID"
+// @Success 200 {object} models.BFGS
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/optimization/bfgs/{id} [get]
+func (s *OptimizationServiceImpl) GetBFGS(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var bfgs models.BFGS
+
+	if err := s.DB.First(&bfgs, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "BFGS data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching BFGS data",
+		})
+	}
+
+	result, err := solveBFGS(bfgs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	bfgs.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(bfgs)
+}
+
+// @Tags BFGS
+// @Summary Create BFGS Result
+// @Description Minimize (or maximize with goal "max") f from x0 with the BFGS quasi-Newton method
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBFGS true "Request Body"
+// @Success 201 {object} models.BFGS
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/optimization/bfgs [post]
+func (s *OptimizationServiceImpl) CreateBFGS(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBFGS)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	bfgs := models.BFGS{
+		Equation:  req.Equation,
+		Variables: req.Variables,
+		X0:        req.X0,
+		E:         req.E,
+		Goal:      req.Goal,
+	}
+
+	result, err := solveBFGS(bfgs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&bfgs).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	bfgs.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(bfgs)
+}
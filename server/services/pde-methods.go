@@ -0,0 +1,295 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	pdeMaxIntervals = 10000
+	// pdeMaxWork caps time steps times grid points.
+	pdeMaxWork = 50000000
+	// pdeMaxSnapshotValues caps snapshots times grid points, since every
+	// snapshot copies the whole grid into the response.
+	pdeMaxSnapshotValues = 1000000
+	// pdeBlowUp is where a solution is taken to have become unstable, well
+	// before it overflows.
+	pdeBlowUp = 1e100
+)
+
+// solveTridiagonal solves the system with sub-diagonal a, diagonal b and
+// super-diagonal c (a[0] and c[n-1] unused) by the Thomas algorithm.
+func solveTridiagonal(a, b, c, d []float64) ([]float64, error) {
+	n := len(d)
+	cp, dp := make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		denominator := b[i]
+		if i > 0 {
+			denominator -= a[i] * cp[i-1]
+		}
+		if denominator == 0 {
+			return nil, fmt.Errorf("tridiagonal system is singular at row %d", i+1)
+		}
+		if i < n-1 {
+			cp[i] = c[i] / denominator
+		}
+		dp[i] = d[i]
+		if i > 0 {
+			dp[i] -= a[i] * dp[i-1]
+		}
+		dp[i] /= denominator
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		x[i] = dp[i]
+		if i < n-1 {
+			x[i] -= cp[i] * x[i+1]
+		}
+	}
+	return x, nil
+}
+
+// pdeGrid holds what the heat and wave solvers share: the grid, the
+// boundary values as functions of t and the steps to record snapshots at.
+type pdeGrid struct {
+	x           []float64
+	dx, dt      float64
+	left, right func(t float64) float64
+	snapshots   []int
+}
+
+// newPDEGrid checks the grid and parses the boundary expressions in t and
+// the comma separated snapshot times, which are rounded to whole steps.
+func newPDEGrid(xLower, xUpper float64, intervals int, dt float64, left, right, times string) (*pdeGrid, error) {
+	if xLower >= xUpper {
+		return nil, fmt.Errorf("x_lower must be less than x_upper")
+	}
+	if intervals < 2 || intervals > pdeMaxIntervals {
+		return nil, fmt.Errorf("intervals must be between 2 and %d", pdeMaxIntervals)
+	}
+	if dt <= 0 {
+		return nil, fmt.Errorf("dt must be positive")
+	}
+	g := &pdeGrid{dx: (xUpper - xLower) / float64(intervals), dt: dt}
+	for i := 0; i <= intervals; i++ {
+		g.x = append(g.x, xLower+float64(i)*g.dx)
+	}
+	for _, boundary := range []struct {
+		name, input string
+		f           *func(float64) float64
+	}{{"left", left, &g.left}, {"right", right, &g.right}} {
+		if strings.TrimSpace(boundary.input) == "" {
+			return nil, fmt.Errorf("%s boundary is required", boundary.name)
+		}
+		expr, err := utils.ParseExpression(boundary.input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s boundary: %v", boundary.name, err)
+		}
+		if err := expr.CheckVariables("t"); err != nil {
+			return nil, fmt.Errorf("invalid %s boundary: %v", boundary.name, err)
+		}
+		*boundary.f = expr.Func("t")
+	}
+	values, err := utils.ParseFloatList(times)
+	if err != nil {
+		return nil, fmt.Errorf("invalid times: %v", err)
+	}
+	sort.Float64s(values)
+	for _, t := range values {
+		if t < 0 {
+			return nil, fmt.Errorf("times cannot be negative")
+		}
+		step := int(math.Round(t / dt))
+		if len(g.snapshots) == 0 || g.snapshots[len(g.snapshots)-1] != step {
+			g.snapshots = append(g.snapshots, step)
+		}
+	}
+	if len(g.snapshots) == 0 {
+		return nil, fmt.Errorf("times is required")
+	}
+	if len(g.snapshots)*len(g.x) > pdeMaxSnapshotValues {
+		return nil, fmt.Errorf("%d snapshots on %d points is more than %d values, use fewer times or intervals", len(g.snapshots), len(g.x), pdeMaxSnapshotValues)
+	}
+	if steps := g.snapshots[len(g.snapshots)-1]; float64(steps)*float64(len(g.x)) > pdeMaxWork {
+		return nil, fmt.Errorf("%d steps on %d points is more than %d updates, use a larger dt or fewer intervals", steps, len(g.x), pdeMaxWork)
+	}
+	return g, nil
+}
+
+// initial evaluates an expression in x at every grid point.
+func (g *pdeGrid) initial(name, input string) ([]float64, error) {
+	expr, err := utils.ParseExpression(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	f := expr.Func("x")
+	u := make([]float64, len(g.x))
+	for i, x := range g.x {
+		u[i] = f(x)
+		if math.IsNaN(u[i]) || math.IsInf(u[i], 0) {
+			return nil, fmt.Errorf("%s is not defined at x = %v", name, x)
+		}
+	}
+	return u, nil
+}
+
+// march applies step to advance u from step n to n + 1, sets the boundary
+// values and records the snapshots. The boundary values replace the
+// initial condition at the ends. It stops early with a warning if the
+// solution blows up.
+func (g *pdeGrid) march(u []float64, step func(n int, u []float64) ([]float64, error)) ([]models.PDESnapshot, []string, error) {
+	u[0], u[len(u)-1] = g.left(0), g.right(0)
+	var snapshots []models.PDESnapshot
+	record := func(n int) {
+		snapshots = append(snapshots, models.PDESnapshot{Time: float64(n) * g.dt, U: append([]float64(nil), u...)})
+	}
+	next := 0
+	for n := 0; ; n++ {
+		for next < len(g.snapshots) && g.snapshots[next] == n {
+			record(n)
+			next++
+		}
+		if next == len(g.snapshots) {
+			return snapshots, nil, nil
+		}
+		var err error
+		if u, err = step(n, u); err != nil {
+			return nil, nil, err
+		}
+		t := float64(n+1) * g.dt
+		u[0], u[len(u)-1] = g.left(t), g.right(t)
+		for _, v := range u {
+			if math.IsNaN(v) || math.Abs(v) > pdeBlowUp {
+				return snapshots, []string{fmt.Sprintf("the solution blew up at t = %v, later snapshots are omitted", t)}, nil
+			}
+		}
+	}
+}
+
+// solveHeatEquation solves u_t = alpha u_xx with Dirichlet boundaries.
+// With r = alpha dt / dx^2 the schemes are, on interior points,
+//
+//	FTCS:            u_i^{n+1} = u_i + r (u_{i-1} - 2 u_i + u_{i+1})
+//	BTCS:            -r u_{i-1}^{n+1} + (1 + 2r) u_i^{n+1} - r u_{i+1}^{n+1} = u_i^n
+//	Crank–Nicolson:  the average of the two, which is second order in time.
+//
+// FTCS is only stable for r <= 1/2; the implicit schemes are stable for
+// every r.
+func solveHeatEquation(p models.HeatEquation) (models.PDEResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "crank-nicolson"
+	}
+	if method != "ftcs" && method != "btcs" && method != "crank-nicolson" {
+		return models.PDEResult{}, fmt.Errorf("method must be ftcs, btcs or crank-nicolson")
+	}
+	if p.Alpha <= 0 {
+		return models.PDEResult{}, fmt.Errorf("alpha must be positive")
+	}
+	g, err := newPDEGrid(p.XLower, p.XUpper, p.Intervals, p.DT, p.Left, p.Right, p.Times)
+	if err != nil {
+		return models.PDEResult{}, err
+	}
+	u, err := g.initial("initial", p.Initial)
+	if err != nil {
+		return models.PDEResult{}, err
+	}
+	r := p.Alpha * g.dt / (g.dx * g.dx)
+	result := models.PDEResult{Method: method, R: r, X: g.x}
+
+	// theta weights the new time level: 0 is FTCS, 1 BTCS, 1/2 Crank–Nicolson.
+	theta := map[string]float64{"ftcs": 0, "btcs": 1, "crank-nicolson": 0.5}[method]
+	m := len(u) - 2
+	a, b, c := make([]float64, m), make([]float64, m), make([]float64, m)
+	for i := range b {
+		a[i], b[i], c[i] = -theta*r, 1+2*theta*r, -theta*r
+	}
+	step := func(n int, u []float64) ([]float64, error) {
+		t := float64(n+1) * g.dt
+		rhs := make([]float64, m)
+		for i := 1; i <= m; i++ {
+			rhs[i-1] = u[i] + (1-theta)*r*(u[i-1]-2*u[i]+u[i+1])
+		}
+		rhs[0] += theta * r * g.left(t)
+		rhs[m-1] += theta * r * g.right(t)
+		next := make([]float64, len(u))
+		if theta == 0 {
+			copy(next[1:], rhs)
+			return next, nil
+		}
+		interior, err := solveTridiagonal(a, b, c, rhs)
+		if err != nil {
+			return nil, err
+		}
+		copy(next[1:], interior)
+		return next, nil
+	}
+	if result.Snapshots, result.Warnings, err = g.march(u, step); err != nil {
+		return models.PDEResult{}, err
+	}
+	if method == "ftcs" && r > 0.5 {
+		result.Warnings = append([]string{fmt.Sprintf("r = %v is greater than 1/2, so FTCS is unstable and errors grow every step", r)}, result.Warnings...)
+	}
+	result.Steps = g.snapshots[len(g.snapshots)-1]
+	return result, nil
+}
+
+// solveWaveEquation solves u_tt = c^2 u_xx with Dirichlet boundaries by the
+// leapfrog scheme
+//
+//	u_i^{n+1} = 2 u_i^n - u_i^{n-1} + s^2 (u_{i-1}^n - 2 u_i^n + u_{i+1}^n),
+//
+// s = c dt / dx, starting from u_i^1 = u_i^0 + dt g_i + s^2/2 (u_{i-1}^0 -
+// 2 u_i^0 + u_{i+1}^0) for the initial velocity g. It is stable for s <= 1.
+func solveWaveEquation(p models.WaveEquation) (models.PDEResult, error) {
+	if p.C <= 0 {
+		return models.PDEResult{}, fmt.Errorf("c must be positive")
+	}
+	g, err := newPDEGrid(p.XLower, p.XUpper, p.Intervals, p.DT, p.Left, p.Right, p.Times)
+	if err != nil {
+		return models.PDEResult{}, err
+	}
+	u, err := g.initial("initial", p.Initial)
+	if err != nil {
+		return models.PDEResult{}, err
+	}
+	velocity := make([]float64, len(u))
+	if strings.TrimSpace(p.Velocity) != "" {
+		if velocity, err = g.initial("velocity", p.Velocity); err != nil {
+			return models.PDEResult{}, err
+		}
+	}
+	s := p.C * g.dt / g.dx
+	result := models.PDEResult{Method: "leapfrog", R: s, X: g.x}
+
+	previous := u
+	step := func(n int, u []float64) ([]float64, error) {
+		next := make([]float64, len(u))
+		for i := 1; i < len(u)-1; i++ {
+			laplacian := u[i-1] - 2*u[i] + u[i+1]
+			if n == 0 {
+				next[i] = u[i] + g.dt*velocity[i] + s*s/2*laplacian
+			} else {
+				next[i] = 2*u[i] - previous[i] + s*s*laplacian
+			}
+		}
+		previous = u
+		return next, nil
+	}
+	if result.Snapshots, result.Warnings, err = g.march(u, step); err != nil {
+		return models.PDEResult{}, err
+	}
+	if s > 1 {
+		result.Warnings = append([]string{fmt.Sprintf("the Courant number c dt / dx = %v is greater than 1, so leapfrog is unstable", s)}, result.Warnings...)
+	}
+	result.Steps = g.snapshots[len(g.snapshots)-1]
+	return result, nil
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/BaimhonS/numerical-method/validations"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type PDEServiceImpl struct {
+	DB *gorm.DB
+}
+
+type PDEService interface {
+	GetHeatEquation(c *fiber.Ctx) error
+	CreateHeatEquation(c *fiber.Ctx) error
+	GetWaveEquation(c *fiber.Ctx) error
+	CreateWaveEquation(c *fiber.Ctx) error
+	GetPoisson(c *fiber.Ctx) error
+	CreatePoisson(c *fiber.Ctx) error
+}
+
+func NewPDEService(db *gorm.DB) PDEService {
+	return &PDEServiceImpl{
+		DB: db,
+	}
+}
+
+// @Tags Heat Equation
+// @Summary Get Heat Equation Result
+// @Description Get the heat equation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Heat Equation ID"
+// @Success 200 {object} models.HeatEquation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/pde/heat/{id} [get]
+func (s *PDEServiceImpl) GetHeatEquation(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var heatEquation models.HeatEquation
+
+	if err := s.DB.First(&heatEquation, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Heat equation data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching heat equation data",
+		})
+	}
+
+	result, err := solveHeatEquation(heatEquation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	heatEquation.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(heatEquation)
+}
+
+// @Tags Heat Equation
+// @Summary Create Heat Equation Result
+// @Description Solve u_t = alpha u_xx with the FTCS, BTCS or Crank-Nicolson scheme and return the solution at the requested times
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqHeatEquation true "Request Body"
+// @Success 201 {object} models.HeatEquation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/pde/heat [post]
+func (s *PDEServiceImpl) CreateHeatEquation(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqHeatEquation)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	heatEquation := models.HeatEquation{
+		Initial:   req.Initial,
+		Alpha:     req.Alpha,
+		XLower:    req.XLower,
+		XUpper:    req.XUpper,
+		Left:      req.Left,
+		Right:     req.Right,
+		Intervals: req.Intervals,
+		DT:        req.DT,
+		Times:     req.Times,
+		Method:    req.Method,
+	}
+
+	result, err := solveHeatEquation(heatEquation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&heatEquation).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	heatEquation.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(heatEquation)
+}
+
+// @Tags Wave Equation
+// @Summary Get Wave Equation Result
+// @Description Get the wave equation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Wave Equation ID"
+// @Success 200 {object} models.WaveEquation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/pde/wave/{id} [get]
+func (s *PDEServiceImpl) GetWaveEquation(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var waveEquation models.WaveEquation
+
+	if err := s.DB.First(&waveEquation, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Wave equation data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching wave equation data",
+		})
+	}
+
+	result, err := solveWaveEquation(waveEquation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	waveEquation.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(waveEquation)
+}
+
+// @Tags Wave Equation
+// @Summary Create Wave Equation Result
+// @Description Solve u_tt = c^2 u_xx with the leapfrog scheme and return the solution at the requested times
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqWaveEquation true "Request Body"
+// @Success 201 {object} models.WaveEquation
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/pde/wave [post]
+func (s *PDEServiceImpl) CreateWaveEquation(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqWaveEquation)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	waveEquation := models.WaveEquation{
+		Initial:   req.Initial,
+		Velocity:  req.Velocity,
+		C:         req.C,
+		XLower:    req.XLower,
+		XUpper:    req.XUpper,
+		Left:      req.Left,
+		Right:     req.Right,
+		Intervals: req.Intervals,
+		DT:        req.DT,
+		Times:     req.Times,
+	}
+
+	result, err := solveWaveEquation(waveEquation)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&waveEquation).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	waveEquation.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(waveEquation)
+}
+
+// @Tags Poisson Equation
+// @Summary Get Poisson Equation Result
+// @Description Get the Poisson equation result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Poisson Equation ID"
+// @Success 200 {object} models.Poisson
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/pde/poisson/{id} [get]
+func (s *PDEServiceImpl) GetPoisson(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var poisson models.Poisson
+
+	if err := s.DB.First(&poisson, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Poisson equation data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching Poisson equation data",
+		})
+	}
+
+	result, err := solvePoisson(poisson)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	poisson.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(poisson)
+}
+
+// @Tags Poisson Equation
+// @Summary Create Poisson Equation Result
+// @Description Solve u_xx + u_yy = f on a rectangle with five point finite differences by Jacobi, Gauss-Seidel, SOR or multigrid and return the grid solution and residual history
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqPoisson true "Request Body"
+// @Success 201 {object} models.Poisson
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/pde/poisson [post]
+func (s *PDEServiceImpl) CreatePoisson(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqPoisson)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	poisson := models.Poisson{
+		Function:   req.Function,
+		XLower:     req.XLower,
+		XUpper:     req.XUpper,
+		YLower:     req.YLower,
+		YUpper:     req.YUpper,
+		XIntervals: req.XIntervals,
+		YIntervals: req.YIntervals,
+		Left:       req.Left,
+		Right:      req.Right,
+		Bottom:     req.Bottom,
+		Top:        req.Top,
+		Neumann:    req.Neumann,
+		Method:     req.Method,
+		Omega:      req.Omega,
+		E:          req.E,
+	}
+
+	result, err := solvePoisson(poisson)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&poisson).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	poisson.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(poisson)
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	poissonMaxIntervals  = 256
+	poissonMaxIterations = 10000
+	poissonMaxCycles     = 100
+	// poissonMaxWork caps iterations times grid points.
+	poissonMaxWork = 100000000
+	// Multigrid smooths twice before and after each coarse correction and
+	// stops coarsening below poissonCoarsest intervals.
+	poissonSmoothing = 2
+	poissonCoarsest  = 4
+	// poissonMaxCoarsest bounds the grid solved directly by multigrid.
+	poissonMaxCoarsest = 32
+)
+
+// poissonSides names the sides in the order of poissonGrid's arrays.
+var poissonSides = [4]string{"left", "right", "bottom", "top"}
+
+// poissonGrid is the five point discretization of u_xx + u_yy = f on a
+// grid with nx by ny intervals. Values are indexed [j][i] for the point
+// (x_i, y_j). A Neumann side holds the outward normal derivative g, used
+// through a ghost point: on the left, u_{-1,j} = u_{1,j} + 2 hx g_j.
+type poissonGrid struct {
+	nx, ny    int
+	hx, hy    float64
+	dirichlet [4]bool
+	neumann   [4][]float64
+	f         [][]float64
+}
+
+func newPoissonField(nx, ny int) [][]float64 {
+	u := make([][]float64, ny+1)
+	for j := range u {
+		u[j] = make([]float64, nx+1)
+	}
+	return u
+}
+
+// active reports whether (i, j) is an unknown, i.e. not on a Dirichlet
+// side.
+func (g *poissonGrid) active(i, j int) bool {
+	return !(i == 0 && g.dirichlet[0] || i == g.nx && g.dirichlet[1] ||
+		j == 0 && g.dirichlet[2] || j == g.ny && g.dirichlet[3])
+}
+
+// neighbours returns the west, east, south and north values around (i, j),
+// using ghost points on Neumann sides.
+func (g *poissonGrid) neighbours(u [][]float64, i, j int) (float64, float64, float64, float64) {
+	normal := func(side, k int) float64 {
+		if g.neumann[side] == nil {
+			return 0
+		}
+		return g.neumann[side][k]
+	}
+	var w, e, s, n float64
+	if i > 0 {
+		w = u[j][i-1]
+	} else {
+		w = u[j][1] + 2*g.hx*normal(0, j)
+	}
+	if i < g.nx {
+		e = u[j][i+1]
+	} else {
+		e = u[j][g.nx-1] + 2*g.hx*normal(1, j)
+	}
+	if j > 0 {
+		s = u[j-1][i]
+	} else {
+		s = u[1][i] + 2*g.hy*normal(2, i)
+	}
+	if j < g.ny {
+		n = u[j+1][i]
+	} else {
+		n = u[g.ny-1][i] + 2*g.hy*normal(3, i)
+	}
+	return w, e, s, n
+}
+
+// solved returns the value at (i, j) that satisfies its equation exactly
+// given the neighbours.
+func (g *poissonGrid) solved(u [][]float64, i, j int) float64 {
+	w, e, s, n := g.neighbours(u, i, j)
+	ax, ay := 1/(g.hx*g.hx), 1/(g.hy*g.hy)
+	return ((w+e)*ax + (s+n)*ay - g.f[j][i]) / (2*ax + 2*ay)
+}
+
+// sweep runs one lexicographic Gauss-Seidel (omega = 1) or SOR sweep.
+func (g *poissonGrid) sweep(u [][]float64, omega float64) {
+	for j := 0; j <= g.ny; j++ {
+		for i := 0; i <= g.nx; i++ {
+			if g.active(i, j) {
+				u[j][i] += omega * (g.solved(u, i, j) - u[j][i])
+			}
+		}
+	}
+}
+
+// jacobi returns the Jacobi update of u.
+func (g *poissonGrid) jacobi(u [][]float64) [][]float64 {
+	next := newPoissonField(g.nx, g.ny)
+	for j := range u {
+		for i := range u[j] {
+			next[j][i] = u[j][i]
+			if g.active(i, j) {
+				next[j][i] = g.solved(u, i, j)
+			}
+		}
+	}
+	return next
+}
+
+// residual returns f - Lu at the unknowns and its 2-norm.
+func (g *poissonGrid) residual(u [][]float64) ([][]float64, float64) {
+	r := newPoissonField(g.nx, g.ny)
+	ax, ay := 1/(g.hx*g.hx), 1/(g.hy*g.hy)
+	sum := 0.0
+	for j := range u {
+		for i := range u[j] {
+			if !g.active(i, j) {
+				continue
+			}
+			w, e, s, n := g.neighbours(u, i, j)
+			r[j][i] = g.f[j][i] - ((w-2*u[j][i]+e)*ax + (s-2*u[j][i]+n)*ay)
+			sum += r[j][i] * r[j][i]
+		}
+	}
+	return r, math.Sqrt(sum)
+}
+
+// coarsest reports whether multigrid stops halving the grid at g.
+func (g *poissonGrid) coarsest() bool {
+	return g.nx%2 != 0 || g.ny%2 != 0 || g.nx < poissonCoarsest || g.ny < poissonCoarsest
+}
+
+// vCycle improves u by one multigrid V-cycle: smooth, solve Le = r for the
+// error on the grid with twice the spacing, from the restricted residual,
+// add the interpolated correction and smooth again. The error equation has
+// the same kinds of sides with zero boundary data. The coarsest grid is
+// solved by enough Gauss-Seidel sweeps to converge.
+func (g *poissonGrid) vCycle(u [][]float64) {
+	if g.coarsest() {
+		for k := 0; k < 50*(g.nx+g.ny); k++ {
+			g.sweep(u, 1)
+		}
+		return
+	}
+	for k := 0; k < poissonSmoothing; k++ {
+		g.sweep(u, 1)
+	}
+	r, _ := g.residual(u)
+	coarse := &poissonGrid{nx: g.nx / 2, ny: g.ny / 2, hx: 2 * g.hx, hy: 2 * g.hy, dirichlet: g.dirichlet}
+	coarse.f = newPoissonField(coarse.nx, coarse.ny)
+	// Full weighting, reflecting across the sides.
+	reflect := func(k, n int) int {
+		if k < 0 {
+			return -k
+		}
+		if k > n {
+			return 2*n - k
+		}
+		return k
+	}
+	for J := 0; J <= coarse.ny; J++ {
+		for I := 0; I <= coarse.nx; I++ {
+			if !coarse.active(I, J) {
+				continue
+			}
+			sum := 0.0
+			for dj := -1; dj <= 1; dj++ {
+				for di := -1; di <= 1; di++ {
+					weight := float64((2-di*di)*(2-dj*dj)) / 16
+					sum += weight * r[reflect(2*J+dj, g.ny)][reflect(2*I+di, g.nx)]
+				}
+			}
+			coarse.f[J][I] = sum
+		}
+	}
+	correction := newPoissonField(coarse.nx, coarse.ny)
+	coarse.vCycle(correction)
+	for j := 0; j <= g.ny; j++ {
+		for i := 0; i <= g.nx; i++ {
+			if !g.active(i, j) {
+				continue
+			}
+			I, J := i/2, j/2
+			I1, J1 := min(I+i%2, coarse.nx), min(J+j%2, coarse.ny)
+			u[j][i] += (correction[J][I] + correction[J][I1] + correction[J1][I] + correction[J1][I1]) / 4
+		}
+	}
+	for k := 0; k < poissonSmoothing; k++ {
+		g.sweep(u, 1)
+	}
+}
+
+// solvePoisson solves u_xx + u_yy = f on a rectangle with the five point
+// stencil. Each side has a Dirichlet value or, when listed in Neumann, an
+// outward normal derivative, both given as expressions in x and y. The
+// iteration starts from zero inside and stops when the residual has
+// dropped by the factor e.
+func solvePoisson(p models.Poisson) (models.PoissonResult, error) {
+	method := strings.ToLower(p.Method)
+	if method == "" {
+		method = "multigrid"
+	}
+	if method != "jacobi" && method != "gauss-seidel" && method != "sor" && method != "multigrid" {
+		return models.PoissonResult{}, fmt.Errorf("method must be jacobi, gauss-seidel, sor or multigrid")
+	}
+	if p.XLower >= p.XUpper || p.YLower >= p.YUpper {
+		return models.PoissonResult{}, fmt.Errorf("lower bounds must be less than upper bounds")
+	}
+	if p.XIntervals < 2 || p.XIntervals > poissonMaxIntervals || p.YIntervals < 2 || p.YIntervals > poissonMaxIntervals {
+		return models.PoissonResult{}, fmt.Errorf("x_intervals and y_intervals must be between 2 and %d", poissonMaxIntervals)
+	}
+	if p.E <= 0 {
+		return models.PoissonResult{}, fmt.Errorf("e must be greater than 0")
+	}
+	parse := func(name, input string) (func(x, y float64) float64, error) {
+		if strings.TrimSpace(input) == "" {
+			input = "0"
+		}
+		expr, err := utils.ParseExpression(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		if err := expr.CheckVariables("x", "y"); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		return func(x, y float64) float64 {
+			return expr.Evaluate(map[string]float64{"x": x, "y": y})
+		}, nil
+	}
+	f, err := parse("function", p.Function)
+	if err != nil {
+		return models.PoissonResult{}, err
+	}
+	var boundary [4]func(x, y float64) float64
+	for k, input := range []string{p.Left, p.Right, p.Bottom, p.Top} {
+		if boundary[k], err = parse(poissonSides[k], input); err != nil {
+			return models.PoissonResult{}, err
+		}
+	}
+
+	g := &poissonGrid{
+		nx: p.XIntervals,
+		ny: p.YIntervals,
+		hx: (p.XUpper - p.XLower) / float64(p.XIntervals),
+		hy: (p.YUpper - p.YLower) / float64(p.YIntervals),
+	}
+	g.dirichlet = [4]bool{true, true, true, true}
+	if strings.TrimSpace(p.Neumann) != "" {
+		for _, side := range strings.Split(p.Neumann, ",") {
+			side = strings.ToLower(strings.TrimSpace(side))
+			found := false
+			for k, name := range poissonSides {
+				if side == name {
+					g.dirichlet[k], found = false, true
+				}
+			}
+			if !found {
+				return models.PoissonResult{}, fmt.Errorf("neumann sides must be left, right, bottom or top, got %q", side)
+			}
+		}
+	}
+	if g.dirichlet == [4]bool{} {
+		return models.PoissonResult{}, fmt.Errorf("at least one side must be Dirichlet, otherwise the solution is not unique")
+	}
+
+	result := models.PoissonResult{Method: method}
+	for i := 0; i <= g.nx; i++ {
+		result.X = append(result.X, p.XLower+float64(i)*g.hx)
+	}
+	for j := 0; j <= g.ny; j++ {
+		result.Y = append(result.Y, p.YLower+float64(j)*g.hy)
+	}
+	u := newPoissonField(g.nx, g.ny)
+	g.f = newPoissonField(g.nx, g.ny)
+	for j, y := range result.Y {
+		for i, x := range result.X {
+			g.f[j][i] = f(x, y)
+		}
+	}
+	for k := range g.neumann {
+		if !g.dirichlet[k] {
+			if k < 2 {
+				g.neumann[k] = make([]float64, g.ny+1)
+			} else {
+				g.neumann[k] = make([]float64, g.nx+1)
+			}
+		}
+	}
+	// Corners belong to the left and right sides when those are Dirichlet.
+	for k := 3; k >= 0; k-- {
+		for j, y := range result.Y {
+			for i, x := range result.X {
+				onSide := [4]bool{i == 0, i == g.nx, j == 0, j == g.ny}[k]
+				if !onSide {
+					continue
+				}
+				value := boundary[k](x, y)
+				if math.IsNaN(value) || math.IsInf(value, 0) {
+					return models.PoissonResult{}, fmt.Errorf("%s boundary is not defined at (%v, %v)", poissonSides[k], x, y)
+				}
+				if g.dirichlet[k] {
+					u[j][i] = value
+				} else if k < 2 {
+					g.neumann[k][j] = value
+				} else {
+					g.neumann[k][i] = value
+				}
+			}
+		}
+	}
+	for j := range g.f {
+		for i, v := range g.f[j] {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return models.PoissonResult{}, fmt.Errorf("function is not defined at (%v, %v)", result.X[i], result.Y[j])
+			}
+		}
+	}
+
+	omega := 1.0
+	if method == "sor" {
+		omega = p.Omega
+		if omega == 0 {
+			// rho of Jacobi for the five point stencil, then the optimal
+			// omega 2 / (1 + sqrt(1 - rho^2)).
+			ax, ay := 1/(g.hx*g.hx), 1/(g.hy*g.hy)
+			rho := (ax*math.Cos(math.Pi/float64(g.nx)) + ay*math.Cos(math.Pi/float64(g.ny))) / (ax + ay)
+			omega = 2 / (1 + math.Sqrt(1-rho*rho))
+		}
+		if omega <= 0 || omega >= 2 {
+			return models.PoissonResult{}, fmt.Errorf("omega must be between 0 and 2")
+		}
+		result.Omega = omega
+	}
+
+	maxIterations := min(poissonMaxIterations, poissonMaxWork/((g.nx+1)*(g.ny+1)))
+	if method == "multigrid" {
+		maxIterations = poissonMaxCycles
+		coarse := *g
+		for !coarse.coarsest() {
+			coarse.nx, coarse.ny = coarse.nx/2, coarse.ny/2
+		}
+		if coarse.nx > poissonMaxCoarsest || coarse.ny > poissonMaxCoarsest {
+			return models.PoissonResult{}, fmt.Errorf("multigrid needs x_intervals and y_intervals that halve down to at most %d, e.g. powers of two", poissonMaxCoarsest)
+		}
+	}
+	_, initial := g.residual(u)
+	for it := 1; it <= maxIterations && initial > 0; it++ {
+		switch method {
+		case "jacobi":
+			u = g.jacobi(u)
+		case "multigrid":
+			g.vCycle(u)
+		default:
+			g.sweep(u, omega)
+		}
+		_, norm := g.residual(u)
+		relative := norm / initial
+		if math.IsNaN(relative) || math.IsInf(relative, 0) {
+			return models.PoissonResult{}, fmt.Errorf("the iteration diverged")
+		}
+		result.Iterations = it
+		result.History = append(result.History, models.PoissonIteration{Iteration: it, Residual: norm, RelativeResidual: relative})
+		if relative <= p.E {
+			break
+		}
+	}
+	result.Converged = initial == 0 || len(result.History) > 0 && result.History[len(result.History)-1].RelativeResidual <= p.E
+	result.U = u
+	return result, nil
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	polynomialMaxIterations = 500
+	polynomialDefaultE      = 1e-12
+	// polynomialMaxDegree bounds the degree of an expanded equation.
+	polynomialMaxDegree = 200
+	// A cluster is reported as one multiple root only if the lower
+	// derivatives vanish there to within this many rounding units.
+	polynomialClusterRounding = 1e3
+
+	machineEpsilon = 0x1p-52
+)
+
+// solvePolynomialRoots finds all complex roots of the polynomial given either
+// as coefficients (highest degree first) or as an equation in x.
+func solvePolynomialRoots(p models.PolynomialRoots) (models.PolynomialRootsResult, error) {
+	var coefficients []float64
+	if p.Coefficients != "" {
+		values, err := utils.ParseFloatList(p.Coefficients)
+		if err != nil {
+			return models.PolynomialRootsResult{}, fmt.Errorf("invalid coefficients: %v", err)
+		}
+		coefficients = values
+	} else {
+		expr, err := utils.ParseExpression(p.Equation)
+		if err != nil {
+			return models.PolynomialRootsResult{}, fmt.Errorf("invalid equation: %v", err)
+		}
+		ascending, err := polynomialCoefficients(expr, "x")
+		if err != nil {
+			return models.PolynomialRootsResult{}, err
+		}
+		for i := len(ascending) - 1; i >= 0; i-- {
+			coefficients = append(coefficients, ascending[i])
+		}
+	}
+
+	// Drop leading zeros so that the first coefficient defines the degree.
+	for len(coefficients) > 0 && coefficients[0] == 0 {
+		coefficients = coefficients[1:]
+	}
+	if len(coefficients) < 2 {
+		return models.PolynomialRootsResult{}, fmt.Errorf("polynomial must have degree at least 1")
+	}
+	if len(coefficients)-1 > polynomialMaxDegree {
+		return models.PolynomialRootsResult{}, fmt.Errorf("polynomial must have degree at most %d", polynomialMaxDegree)
+	}
+	for _, a := range coefficients {
+		if math.IsNaN(a) || math.IsInf(a, 0) {
+			return models.PolynomialRootsResult{}, fmt.Errorf("coefficients must be finite")
+		}
+	}
+
+	e := p.E
+	if e <= 0 {
+		e = polynomialDefaultE
+	}
+
+	roots, iterations := findPolynomialRoots(coefficients, e)
+	return models.PolynomialRootsResult{
+		Coefficients: coefficients,
+		Degree:       len(coefficients) - 1,
+		Iterations:   iterations,
+		Roots:        roots,
+	}, nil
+}
+
+// findPolynomialRoots returns the distinct roots of a polynomial with
+// coefficients in descending order and a non-zero leading coefficient,
+// together with the number of Aberth iterations used.
+func findPolynomialRoots(coefficients []float64, e float64) ([]models.PolynomialRoot, int) {
+	// Trailing zero coefficients are roots at the origin.
+	zeroRoots := 0
+	reduced := coefficients
+	for len(reduced) > 1 && reduced[len(reduced)-1] == 0 {
+		reduced = reduced[:len(reduced)-1]
+		zeroRoots++
+	}
+
+	var approximations []complex128
+	iterations := 0
+	if len(reduced) > 1 {
+		approximations, iterations = aberthRoots(reduced, e)
+	}
+	for i := 0; i < zeroRoots; i++ {
+		approximations = append(approximations, 0)
+	}
+	return clusterPolynomialRoots(coefficients, approximations), iterations
+}
+
+// aberthRoots runs the Aberth–Ehrlich simultaneous iteration on a polynomial
+// with coefficients in descending order and a non-zero constant term.
+func aberthRoots(coefficients []float64, e float64) ([]complex128, int) {
+	n := len(coefficients) - 1
+
+	// Start on a circle whose radius bounds the root moduli (Fujiwara bound),
+	// rotated off the real axis so conjugate pairs can separate.
+	radius := 0.0
+	for i := 1; i <= n; i++ {
+		radius = math.Max(radius, math.Pow(math.Abs(coefficients[i]/coefficients[0]), 1/float64(i)))
+	}
+	radius *= 2
+	z := make([]complex128, n)
+	for k := range z {
+		z[k] = cmplx.Rect(radius, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+
+	iteration := 0
+	for iteration < polynomialMaxIterations {
+		iteration++
+		converged := true
+		for k := range z {
+			value, derivative := hornerComplex(coefficients, z[k])
+			if value == 0 {
+				continue
+			}
+			ratio := value / derivative
+			sum := complex(0, 0)
+			for j := range z {
+				if j != k {
+					sum += 1 / (z[k] - z[j])
+				}
+			}
+			step := ratio / (1 - ratio*sum)
+			if cmplx.IsNaN(step) || cmplx.IsInf(step) {
+				continue
+			}
+			z[k] -= step
+			if cmplx.Abs(step) > e*(1+cmplx.Abs(z[k])) {
+				converged = false
+			}
+		}
+		if converged {
+			break
+		}
+	}
+	return z, iteration
+}
+
+// clusterPolynomialRoots merges approximations that belong to the same
+// multiple root and reports each distinct root with its residual |p(z)|.
+//
+// The disk of radius n (|p(z)| + u S(z)) / |p'(z)| around an approximation
+// z contains a root, where S bounds the rounding error in p(z), so
+// approximations whose disks overlap are candidates for one root. Such a
+// cluster of m members is only taken as a root of multiplicity m if, at the
+// root of p^(m-1) near its centroid, p, p', ..., p^(m-2) all vanish to
+// within rounding. Otherwise the member farthest from the centroid is
+// split off, to be clustered again later, and the test repeated, so
+// distinct close roots stay apart.
+func clusterPolynomialRoots(coefficients []float64, approximations []complex128) []models.PolynomialRoot {
+	n := float64(len(coefficients) - 1)
+	radii := make([]float64, len(approximations))
+	for i, z := range approximations {
+		value, derivative := hornerComplex(coefficients, z)
+		radii[i] = math.Inf(1)
+		if derivative != 0 {
+			radii[i] = n * (cmplx.Abs(value) + machineEpsilon*polynomialScale(coefficients, z)) / cmplx.Abs(derivative)
+		}
+	}
+
+	used := make([]bool, len(approximations))
+	var roots []models.PolynomialRoot
+	for i := range approximations {
+		if used[i] {
+			continue
+		}
+		members := []int{i}
+		for j := i + 1; j < len(approximations); j++ {
+			if !used[j] && cmplx.Abs(approximations[i]-approximations[j]) <= radii[i]+radii[j] {
+				members = append(members, j)
+			}
+		}
+
+		centroid := approximations[i]
+		for len(members) > 1 {
+			centroid = 0
+			for _, m := range members {
+				centroid += approximations[m]
+			}
+			centroid /= complex(float64(len(members)), 0)
+			// The root lies in the members' disks, so the refinement may not
+			// move further than they reach.
+			reach := 0.0
+			for _, m := range members {
+				reach = math.Max(reach, cmplx.Abs(approximations[m]-centroid)+radii[m])
+			}
+			if root, ok := refineMultipleRoot(coefficients, centroid, len(members), reach); ok {
+				centroid = root
+				break
+			}
+			// Keep the first member, which the cluster was gathered around.
+			farthest := 1
+			for k := 2; k < len(members); k++ {
+				if cmplx.Abs(approximations[members[k]]-centroid) > cmplx.Abs(approximations[members[farthest]]-centroid) {
+					farthest = k
+				}
+			}
+			members = append(members[:farthest], members[farthest+1:]...)
+			centroid = approximations[i]
+		}
+		for _, m := range members {
+			used[m] = true
+		}
+
+		// Coefficients are real, so drop imaginary parts at rounding level.
+		if math.Abs(imag(centroid)) <= 1e-10*(1+math.Abs(real(centroid))) {
+			centroid = complex(real(centroid), 0)
+		}
+		value, _ := hornerComplex(coefficients, centroid)
+		roots = append(roots, models.PolynomialRoot{
+			Real:         real(centroid),
+			Imag:         imag(centroid),
+			Multiplicity: len(members),
+			Residual:     cmplx.Abs(value),
+		})
+	}
+
+	sort.Slice(roots, func(a, b int) bool {
+		if roots[a].Real != roots[b].Real {
+			return roots[a].Real < roots[b].Real
+		}
+		return roots[a].Imag < roots[b].Imag
+	})
+	return roots
+}
+
+// refineMultipleRoot polishes a candidate root of multiplicity m with
+// Newton's method on p^(m-1), for which the root is simple and convergence
+// is quadratic. It reports false if the iteration moves further than limit
+// from the start, or if p, ..., p^(m-2) do not vanish at the result to
+// within polynomialClusterRounding units of their rounding error.
+func refineMultipleRoot(coefficients []float64, z complex128, m int, limit float64) (complex128, bool) {
+	derivatives := [][]float64{coefficients}
+	for k := 1; k < m; k++ {
+		derivatives = append(derivatives, polynomialDerivative(derivatives[k-1]))
+	}
+	start := z
+	for i := 0; i < polynomialMaxIterations; i++ {
+		value, slope := hornerComplex(derivatives[m-1], z)
+		if value == 0 || slope == 0 {
+			break
+		}
+		step := value / slope
+		z -= step
+		if cmplx.Abs(step) <= machineEpsilon*(1+cmplx.Abs(z)) {
+			break
+		}
+	}
+	if cmplx.IsNaN(z) || cmplx.Abs(z-start) > limit+machineEpsilon*(1+cmplx.Abs(start)) {
+		return start, false
+	}
+	for _, derivative := range derivatives[:m-1] {
+		value, _ := hornerComplex(derivative, z)
+		if cmplx.Abs(value) > polynomialClusterRounding*machineEpsilon*polynomialScale(derivative, z) {
+			return start, false
+		}
+	}
+	return z, true
+}
+
+// polynomialDerivative differentiates coefficients given in descending order.
+func polynomialDerivative(coefficients []float64) []float64 {
+	n := len(coefficients) - 1
+	if n == 0 {
+		return []float64{0}
+	}
+	result := make([]float64, n)
+	for i := 0; i < n; i++ {
+		result[i] = coefficients[i] * float64(n-i)
+	}
+	return result
+}
+
+// hornerComplex evaluates p(z) and p'(z) for coefficients in descending order.
+func hornerComplex(coefficients []float64, z complex128) (complex128, complex128) {
+	value := complex(coefficients[0], 0)
+	derivative := complex(0, 0)
+	for _, a := range coefficients[1:] {
+		derivative = derivative*z + value
+		value = value*z + complex(a, 0)
+	}
+	return value, derivative
+}
+
+// polynomialScale is the magnitude sum used to judge rounding error in p(z).
+func polynomialScale(coefficients []float64, z complex128) float64 {
+	scale := 0.0
+	r := cmplx.Abs(z)
+	for _, a := range coefficients {
+		scale = scale*r + math.Abs(a)
+	}
+	return scale
+}
+
+// polynomialCoefficients expands an expression into polynomial coefficients
+// (lowest degree first), or reports that it is not a polynomial in variable.
+func polynomialCoefficients(expr *utils.Expression, variable string) ([]float64, error) {
+	switch expr.Kind {
+	case utils.ExprNumber:
+		return []float64{expr.Value}, nil
+	case utils.ExprVariable:
+		if expr.Name == variable {
+			return []float64{0, 1}, nil
+		}
+		value := expr.Evaluate(nil)
+		if math.IsNaN(value) {
+			return nil, fmt.Errorf("equation is not a polynomial in %s: unknown variable %q", variable, expr.Name)
+		}
+		return []float64{value}, nil
+	case utils.ExprUnary:
+		operand, err := polynomialCoefficients(expr.Args[0], variable)
+		if err != nil {
+			return nil, err
+		}
+		return polynomialScaled(operand, -1), nil
+	case utils.ExprBinary:
+		left, err := polynomialCoefficients(expr.Args[0], variable)
+		if err != nil {
+			return nil, err
+		}
+		switch expr.Op {
+		case "^":
+			exponent, err := polynomialCoefficients(expr.Args[1], variable)
+			if err != nil || len(exponent) != 1 || exponent[0] < 0 || exponent[0] != math.Trunc(exponent[0]) {
+				return nil, fmt.Errorf("equation is not a polynomial in %s: exponents must be non-negative integers", variable)
+			}
+			if len(left) == 1 {
+				return []float64{math.Pow(left[0], exponent[0])}, nil
+			}
+			if exponent[0]*float64(len(left)-1) > polynomialMaxDegree {
+				return nil, fmt.Errorf("equation has degree greater than %d", polynomialMaxDegree)
+			}
+			result := []float64{1}
+			for i := 0; i < int(exponent[0]); i++ {
+				result = polynomialProduct(result, left)
+			}
+			return result, nil
+		}
+		right, err := polynomialCoefficients(expr.Args[1], variable)
+		if err != nil {
+			return nil, err
+		}
+		switch expr.Op {
+		case "+":
+			return polynomialSum(left, right), nil
+		case "-":
+			return polynomialSum(left, polynomialScaled(right, -1)), nil
+		case "*":
+			if len(left)+len(right)-2 > polynomialMaxDegree {
+				return nil, fmt.Errorf("equation has degree greater than %d", polynomialMaxDegree)
+			}
+			return polynomialProduct(left, right), nil
+		case "/":
+			if len(right) != 1 || right[0] == 0 {
+				return nil, fmt.Errorf("equation is not a polynomial in %s: division by a non-constant", variable)
+			}
+			return polynomialScaled(left, 1/right[0]), nil
+		}
+	}
+	return nil, fmt.Errorf("equation is not a polynomial in %s", variable)
+}
+
+func polynomialSum(a, b []float64) []float64 {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	result := append([]float64(nil), a...)
+	for i, v := range b {
+		result[i] += v
+	}
+	return result
+}
+
+func polynomialProduct(a, b []float64) []float64 {
+	result := make([]float64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			result[i+j] += x * y
+		}
+	}
+	return result
+}
+
+func polynomialScaled(a []float64, factor float64) []float64 {
+	result := make([]float64, len(a))
+	for i, v := range a {
+		result[i] = v * factor
+	}
+	return result
+}
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	// precisionMaxIterations bounds the bracketing methods; the client pages
+	// stop at 50, but a higher precision can keep improving for longer.
+	precisionMaxIterations = 1000
+	// precisionMaxIntervals keeps big.Float integration to a few seconds.
+	precisionMaxIntervals = 100000
+	// onePointStart is where the one-point client page starts, as the
+	// method has no initial value input.
+	onePointStart = 1.0
+)
+
+// bigDigits is the number of decimal digits shown for a prec-bit mantissa.
+func bigDigits(prec uint) int {
+	return int(math.Ceil(float64(prec)*math.Log10(2))) + 1
+}
+
+func bigText(v *big.Float) string {
+	return v.Text('g', bigDigits(v.Prec()))
+}
+
+// bigDifference is |b - f| rounded to float64.
+func bigDifference(f float64, b *big.Float) float64 {
+	d := new(big.Float).SetPrec(b.Prec() + 64).SetFloat64(f)
+	d.Sub(b, d)
+	return utils.BigFloat64(d.Abs(d))
+}
+
+// comparePrecision fills the float64 and big.Float columns of the result.
+func comparePrecision(prec uint, float64Values []float64, bigValues []*big.Float) (*models.PrecisionResult, error) {
+	result := &models.PrecisionResult{Precision: prec}
+	for i, f := range float64Values {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("the float64 computation is not finite")
+		}
+		result.Float64 = append(result.Float64, f)
+		result.BigFloat = append(result.BigFloat, bigText(bigValues[i]))
+		result.Difference = append(result.Difference, bigDifference(f, bigValues[i]))
+	}
+	return result, nil
+}
+
+// bracketFloat64 runs bisection or false position as the client pages do,
+// returning the new estimate of every iteration.
+func bracketFloat64(f func(float64) float64, xl, xr, e float64, falsePosition bool) ([]float64, error) {
+	var estimates []float64
+	for it := 0; it < precisionMaxIterations; it++ {
+		fl, fr := f(xl), f(xr)
+		xm := (xl + xr) / 2
+		if falsePosition {
+			xm = xr - fr*(xl-xr)/(fl-fr)
+		}
+		fm := f(xm)
+		if math.IsNaN(fm) || math.IsInf(fm, 0) || math.IsNaN(xm) || math.IsInf(xm, 0) {
+			return nil, fmt.Errorf("equation is not defined at iteration %d", it+1)
+		}
+		estimates = append(estimates, xm)
+
+		var ea float64
+		if fm*fr > 0 {
+			ea = math.Abs((xm-xr)/xm) * 100
+			xr = xm
+		} else {
+			ea = math.Abs((xm-xl)/xm) * 100
+			xl = xm
+		}
+		if !(ea > e) {
+			break
+		}
+	}
+	return estimates, nil
+}
+
+// bracketBig is bracketFloat64 in big.Float arithmetic with prec bits.
+func bracketBig(expr *utils.Expression, xl, xr, e float64, prec uint, falsePosition bool) ([]*big.Float, error) {
+	f := func(x *big.Float) (*big.Float, error) {
+		return expr.EvaluateBig(map[string]*big.Float{"x": x}, prec)
+	}
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	l, r := newFloat().SetFloat64(xl), newFloat().SetFloat64(xr)
+	tolerance := newFloat().SetFloat64(e)
+	hundred := newFloat().SetInt64(100)
+
+	var estimates []*big.Float
+	for it := 0; it < precisionMaxIterations; it++ {
+		fl, err := f(l)
+		if err != nil {
+			return nil, err
+		}
+		fr, err := f(r)
+		if err != nil {
+			return nil, err
+		}
+		m := newFloat().Add(l, r)
+		m.Quo(m, newFloat().SetInt64(2))
+		if falsePosition {
+			den := newFloat().Sub(fl, fr)
+			if den.Sign() == 0 {
+				return nil, fmt.Errorf("f(xl) = f(xr) at iteration %d", it+1)
+			}
+			m = newFloat().Sub(l, r)
+			m.Mul(m, fr)
+			m.Quo(m, den)
+			m.Sub(r, m)
+		}
+		fm, err := f(m)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, m)
+		if m.Sign() == 0 {
+			break
+		}
+
+		var old *big.Float
+		if fm.Sign()*fr.Sign() > 0 {
+			old, r = r, m
+		} else {
+			old, l = l, m
+		}
+		ea := newFloat().Sub(m, old)
+		ea.Quo(ea, m)
+		ea.Abs(ea).Mul(ea, hundred)
+		if ea.Cmp(tolerance) <= 0 {
+			break
+		}
+	}
+	return estimates, nil
+}
+
+// precisionBracket compares bisection or false position in float64 and in
+// big.Float, iteration by iteration.
+func precisionBracket(equation string, xl, xr, e float64, prec uint, falsePosition bool) (*models.PrecisionResult, error) {
+	expr, err := utils.ParseExpression(equation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	float64Estimates, err := bracketFloat64(expr.Func("x"), xl, xr, e, falsePosition)
+	if err != nil {
+		return nil, err
+	}
+	bigEstimates, err := bracketBig(expr, xl, xr, e, prec, falsePosition)
+	if err != nil {
+		return nil, err
+	}
+	return precisionSteps(prec, float64Estimates, bigEstimates)
+}
+
+// openFloat64 runs the one-point, Newton-Raphson or secant method as the
+// client pages do, returning the new estimate of every iteration. One-point
+// iterates x = g(x) from x0, Newton-Raphson starts from x0 and secant from
+// x0 and x1.
+func openFloat64(expr, derivative *utils.Expression, method string, x0, x1, e float64) ([]float64, error) {
+	f := expr.Func("x")
+	var df func(float64) float64
+	if derivative != nil {
+		df = derivative.Func("x")
+	}
+	var estimates []float64
+	for it := 0; it < precisionMaxIterations; it++ {
+		var x float64
+		switch method {
+		case "one-point":
+			x = f(x0)
+		case "newton-raphson":
+			x = x0 - f(x0)/df(x0)
+		default:
+			x = x1 - f(x1)*(x1-x0)/(f(x1)-f(x0))
+		}
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("equation is not defined at iteration %d", it+1)
+		}
+		estimates = append(estimates, x)
+
+		old := x0
+		if method == "secant" {
+			old, x0, x1 = x1, x1, x
+		} else {
+			x0 = x
+		}
+		if !(math.Abs((x-old)/x)*100 > e) {
+			break
+		}
+	}
+	return estimates, nil
+}
+
+// openBig is openFloat64 in big.Float arithmetic with prec bits.
+func openBig(expr, derivative *utils.Expression, method string, x0, x1, e float64, prec uint) ([]*big.Float, error) {
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	a, b := newFloat().SetFloat64(x0), newFloat().SetFloat64(x1)
+	tolerance := newFloat().SetFloat64(e)
+	hundred := newFloat().SetInt64(100)
+
+	var estimates []*big.Float
+	for it := 0; it < precisionMaxIterations; it++ {
+		// An infinite value would make the next step Inf - Inf, which
+		// big.Float reports by panicking.
+		f := func(g *utils.Expression, x *big.Float) (*big.Float, error) {
+			v, err := g.EvaluateBig(map[string]*big.Float{"x": x}, prec)
+			if err == nil && v.IsInf() {
+				err = fmt.Errorf("equation is not defined at iteration %d", it+1)
+			}
+			return v, err
+		}
+		var x *big.Float
+		switch method {
+		case "one-point":
+			v, err := f(expr, a)
+			if err != nil {
+				return nil, err
+			}
+			x = v
+		case "newton-raphson":
+			fa, err := f(expr, a)
+			if err != nil {
+				return nil, err
+			}
+			da, err := f(derivative, a)
+			if err != nil {
+				return nil, err
+			}
+			if da.Sign() == 0 {
+				return nil, fmt.Errorf("f'(x) = 0 at iteration %d", it+1)
+			}
+			x = newFloat().Quo(fa, da)
+			x.Sub(a, x)
+		default:
+			fa, err := f(expr, a)
+			if err != nil {
+				return nil, err
+			}
+			fb, err := f(expr, b)
+			if err != nil {
+				return nil, err
+			}
+			den := newFloat().Sub(fb, fa)
+			if den.Sign() == 0 {
+				return nil, fmt.Errorf("f(x0) = f(x1) at iteration %d", it+1)
+			}
+			x = newFloat().Sub(b, a)
+			x.Mul(x, fb)
+			x.Quo(x, den)
+			x.Sub(b, x)
+		}
+		if x.IsInf() {
+			return nil, fmt.Errorf("the iteration diverged at iteration %d", it+1)
+		}
+		estimates = append(estimates, x)
+		if x.Sign() == 0 {
+			break
+		}
+
+		old := a
+		if method == "secant" {
+			old, a, b = b, b, x
+		} else {
+			a = x
+		}
+		ea := newFloat().Sub(x, old)
+		ea.Quo(ea, x)
+		ea.Abs(ea).Mul(ea, hundred)
+		if ea.Cmp(tolerance) <= 0 {
+			break
+		}
+	}
+	return estimates, nil
+}
+
+// precisionOpen compares the one-point, Newton-Raphson or secant method in
+// float64 and in big.Float, iteration by iteration. Newton-Raphson uses the
+// symbolic derivative of the equation.
+func precisionOpen(equation, method string, x0, x1, e float64, prec uint) (*models.PrecisionResult, error) {
+	expr, err := utils.ParseExpression(equation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return nil, fmt.Errorf("invalid equation: %v", err)
+	}
+	var derivative *utils.Expression
+	if method == "newton-raphson" {
+		if derivative, err = expr.Derivative("x"); err != nil {
+			return nil, fmt.Errorf("cannot differentiate the equation: %v", err)
+		}
+	}
+	float64Estimates, err := openFloat64(expr, derivative, method, x0, x1, e)
+	if err != nil {
+		return nil, err
+	}
+	bigEstimates, err := openBig(expr, derivative, method, x0, x1, e, prec)
+	if err != nil {
+		return nil, err
+	}
+	return precisionSteps(prec, float64Estimates, bigEstimates)
+}
+
+// precisionSteps lists the float64 and big.Float estimates side by side. A
+// method that has already stopped keeps its final estimate in the later
+// steps.
+func precisionSteps(prec uint, float64Estimates []float64, bigEstimates []*big.Float) (*models.PrecisionResult, error) {
+	last := func(i, n int) int {
+		if i < n {
+			return i
+		}
+		return n - 1
+	}
+	result, err := comparePrecision(prec,
+		float64Estimates[len(float64Estimates)-1:],
+		bigEstimates[len(bigEstimates)-1:])
+	if err != nil {
+		return nil, err
+	}
+	result.Float64Iterations = len(float64Estimates)
+	result.BigFloatIterations = len(bigEstimates)
+	for i := 0; i < len(float64Estimates) || i < len(bigEstimates); i++ {
+		f := float64Estimates[last(i, len(float64Estimates))]
+		b := bigEstimates[last(i, len(bigEstimates))]
+		result.Steps = append(result.Steps, models.PrecisionStep{
+			Iteration:  i + 1,
+			Float64:    f,
+			BigFloat:   bigText(b),
+			Difference: bigDifference(f, b),
+		})
+	}
+	return result, nil
+}
+
+// solveBigLinearSystem is Gaussian elimination with partial pivoting in
+// big.Float arithmetic.
+func solveBigLinearSystem(A [][]*big.Float, b []*big.Float, prec uint) ([]*big.Float, error) {
+	n := len(b)
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if newFloat().Abs(A[i][k]).Cmp(newFloat().Abs(A[pivot][k])) > 0 {
+				pivot = i
+			}
+		}
+		if A[pivot][k].Sign() == 0 {
+			return nil, fmt.Errorf("matrix is singular")
+		}
+		A[k], A[pivot] = A[pivot], A[k]
+		b[k], b[pivot] = b[pivot], b[k]
+		for i := k + 1; i < n; i++ {
+			factor := newFloat().Quo(A[i][k], A[k][k])
+			for j := k; j < n; j++ {
+				A[i][j].Sub(A[i][j], newFloat().Mul(factor, A[k][j]))
+			}
+			b[i].Sub(b[i], newFloat().Mul(factor, b[k]))
+		}
+	}
+	x := make([]*big.Float, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := newFloat().Set(b[i])
+		for j := i + 1; j < n; j++ {
+			sum.Sub(sum, newFloat().Mul(A[i][j], x[j]))
+		}
+		x[i] = sum.Quo(sum, A[i][i])
+	}
+	return x, nil
+}
+
+// precisionMatrix compares Gaussian elimination in float64 and big.Float.
+// The big.Float system is read from the input text, not from float64 values.
+func precisionMatrix(size int, matrixData, constantData string, prec uint) (*models.PrecisionResult, error) {
+	A, b, err := newDenseSystem(size, matrixData, constantData)
+	if err != nil {
+		return nil, err
+	}
+	x, err := solveLinearSystem(A, b)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := utils.ParseBigFloatList(matrixData, prec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matrix_data: %v", err)
+	}
+	bigB, err := utils.ParseBigFloatList(constantData, prec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constant_data: %v", err)
+	}
+	bigA := make([][]*big.Float, size)
+	for i := range bigA {
+		bigA[i] = values[i*size : (i+1)*size]
+	}
+	bigX, err := solveBigLinearSystem(bigA, bigB, prec)
+	if err != nil {
+		return nil, err
+	}
+	return comparePrecision(prec, x, bigX)
+}
+
+// precisionIntegral compares the composite trapezoid (or Simpson 1/3) rule
+// in float64 and big.Float.
+func precisionIntegral(function string, lower, upper float64, n int, prec uint, simpson bool) (*models.PrecisionResult, error) {
+	expr, err := utils.ParseExpression(function)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return nil, fmt.Errorf("invalid function: %v", err)
+	}
+	if n < 1 || n > precisionMaxIntervals {
+		return nil, fmt.Errorf("interval must be between 1 and %d when precision is set", precisionMaxIntervals)
+	}
+	if simpson && n%2 != 0 {
+		return nil, fmt.Errorf("interval must be even for Simpson's rule")
+	}
+	weight := func(i int) int64 {
+		switch {
+		case i == 0 || i == n:
+			return 1
+		case !simpson:
+			return 2
+		case i%2 == 1:
+			return 4
+		default:
+			return 2
+		}
+	}
+	divisor := int64(2)
+	if simpson {
+		divisor = 3
+	}
+
+	f := expr.Func("x")
+	h := (upper - lower) / float64(n)
+	sum := 0.0
+	for i := 0; i <= n; i++ {
+		sum += float64(weight(i)) * f(lower+float64(i)*h)
+	}
+	integral := h / float64(divisor) * sum
+	// A function that is not finite in float64 is likely to overflow in
+	// big.Float too, so it is rejected before the slower computation.
+	if math.IsNaN(integral) || math.IsInf(integral, 0) {
+		return nil, fmt.Errorf("the float64 computation is not finite")
+	}
+
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	a := newFloat().SetFloat64(lower)
+	bigH := newFloat().SetFloat64(upper)
+	bigH.Sub(bigH, a).Quo(bigH, newFloat().SetInt64(int64(n)))
+	bigSum := newFloat()
+	for i := 0; i <= n; i++ {
+		x := newFloat().Mul(bigH, newFloat().SetInt64(int64(i)))
+		fx, err := expr.EvaluateBig(map[string]*big.Float{"x": x.Add(x, a)}, prec)
+		if err != nil {
+			return nil, err
+		}
+		bigSum.Add(bigSum, fx.Mul(fx, newFloat().SetInt64(weight(i))))
+	}
+	bigIntegral := newFloat().Quo(bigH, newFloat().SetInt64(divisor))
+	bigIntegral.Mul(bigIntegral, bigSum)
+	return comparePrecision(prec, []float64{integral}, []*big.Float{bigIntegral})
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+// pcgPreconditioners are run in this order on every PCG problem.
+var pcgPreconditioners = []string{"none", "jacobi", "ssor", "ic0"}
+
+// preconditioner applies z = M^-1 r.
+type preconditioner func(r, z []float64)
+
+// newMatrixEntries reads a square row-major matrix_data as sparse entries.
+func newMatrixEntries(size int, matrixData string) ([]utils.SparseEntry, error) {
+	if size < 1 || size > krylovMaxSize {
+		return nil, fmt.Errorf("matrix_size must be between 1 and %d", krylovMaxSize)
+	}
+	values, err := utils.ParseFloatList(matrixData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matrix_data: %v", err)
+	}
+	if len(values) != size*size {
+		return nil, fmt.Errorf("matrix_data must have %d values", size*size)
+	}
+	var entries []utils.SparseEntry
+	for k, value := range values {
+		if value != 0 {
+			entries = append(entries, utils.SparseEntry{Row: k / size, Col: k % size, Value: value})
+		}
+	}
+	return entries, nil
+}
+
+// diagonal returns the diagonal of A.
+func (m *sparseMatrix) diagonal() []float64 {
+	d := make([]float64, m.n)
+	for i := 0; i < m.n; i++ {
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			if m.cols[k] == i {
+				d[i] = m.values[k]
+			}
+		}
+	}
+	return d
+}
+
+// isSymmetric compares A with its transpose entry by entry.
+func (m *sparseMatrix) isSymmetric() bool {
+	scale := 0.0
+	var transposed []utils.SparseEntry
+	for i := 0; i < m.n; i++ {
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			scale = math.Max(scale, math.Abs(m.values[k]))
+			transposed = append(transposed, utils.SparseEntry{Row: m.cols[k], Col: i, Value: m.values[k]})
+		}
+	}
+	t := newSparseMatrix(m.n, transposed)
+	for i := 0; i < m.n; i++ {
+		if m.rowPtr[i+1]-m.rowPtr[i] != t.rowPtr[i+1]-t.rowPtr[i] {
+			return false
+		}
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			l := t.rowPtr[i] + k - m.rowPtr[i]
+			if m.cols[k] != t.cols[l] || math.Abs(m.values[k]-t.values[l]) > 1e-12*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// jacobiPreconditioner uses M = diag(A).
+func jacobiPreconditioner(A *sparseMatrix) preconditioner {
+	d := A.diagonal()
+	return func(r, z []float64) {
+		for i := range r {
+			z[i] = r[i] / d[i]
+		}
+	}
+}
+
+// ssorPreconditioner uses M = w/(2-w) (D/w + L) (D/w)^-1 (D/w + U), applied
+// as a forward and a backward triangular sweep.
+func ssorPreconditioner(A *sparseMatrix, omega float64) preconditioner {
+	d := A.diagonal()
+	return func(r, z []float64) {
+		for i := 0; i < A.n; i++ {
+			sum := r[i]
+			for k := A.rowPtr[i]; k < A.rowPtr[i+1]; k++ {
+				if j := A.cols[k]; j < i {
+					sum -= A.values[k] * z[j]
+				}
+			}
+			z[i] = sum * omega / d[i]
+		}
+		for i := range z {
+			z[i] *= d[i] / omega
+		}
+		for i := A.n - 1; i >= 0; i-- {
+			sum := z[i]
+			for k := A.rowPtr[i]; k < A.rowPtr[i+1]; k++ {
+				if j := A.cols[k]; j > i {
+					sum -= A.values[k] * z[j]
+				}
+			}
+			z[i] = sum * omega / d[i]
+		}
+		for i := range z {
+			z[i] *= (2 - omega) / omega
+		}
+	}
+}
+
+// ic0Preconditioner uses M = L L^T where L is the incomplete Cholesky factor
+// restricted to the sparsity pattern of the lower triangle of A.
+func ic0Preconditioner(A *sparseMatrix) (preconditioner, error) {
+	n := A.n
+	L := &sparseMatrix{n: n, rowPtr: make([]int, n+1)}
+	diag := make([]int, n)
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		start := len(L.cols)
+		for k := A.rowPtr[i]; k < A.rowPtr[i+1]; k++ {
+			if A.cols[k] <= i {
+				position[A.cols[k]] = len(L.cols)
+				L.cols = append(L.cols, A.cols[k])
+				L.values = append(L.values, A.values[k])
+			}
+		}
+		if position[i] < 0 {
+			return nil, fmt.Errorf("incomplete Cholesky needs a non-zero diagonal at row %d", i+1)
+		}
+		for k := start; k < len(L.cols); k++ {
+			j := L.cols[k]
+			// l_ij -= sum over m < j of l_im l_jm, then divide by l_jj.
+			from, to := start, k
+			if j < i {
+				from, to = L.rowPtr[j], diag[j]
+			}
+			for q := from; q < to; q++ {
+				if p := position[L.cols[q]]; p >= 0 {
+					L.values[k] -= L.values[p] * L.values[q]
+				}
+			}
+			if j < i {
+				L.values[k] /= L.values[diag[j]]
+				continue
+			}
+			if L.values[k] <= 0 {
+				return nil, fmt.Errorf("incomplete Cholesky broke down with a non-positive pivot at row %d", i+1)
+			}
+			L.values[k] = math.Sqrt(L.values[k])
+			diag[i] = k
+		}
+		for k := start; k < len(L.cols); k++ {
+			position[L.cols[k]] = -1
+		}
+		L.rowPtr[i+1] = len(L.cols)
+	}
+
+	return func(r, z []float64) {
+		for i := 0; i < n; i++ {
+			sum := r[i]
+			for k := L.rowPtr[i]; k < diag[i]; k++ {
+				sum -= L.values[k] * z[L.cols[k]]
+			}
+			z[i] = sum / L.values[diag[i]]
+		}
+		for i := n - 1; i >= 0; i-- {
+			z[i] /= L.values[diag[i]]
+			for k := L.rowPtr[i]; k < diag[i]; k++ {
+				z[L.cols[k]] -= L.values[k] * z[i]
+			}
+		}
+	}, nil
+}
+
+// conjugateGradient runs preconditioned CG from x0 = 0 until
+// ||b - Ax|| / ||b|| < e.
+func conjugateGradient(A *sparseMatrix, b []float64, M preconditioner, e float64) ([]float64, int, bool, []float64, error) {
+	n := A.n
+	x := make([]float64, n)
+	bNorm := vectorNorm(b)
+	if bNorm == 0 {
+		return x, 0, true, nil, nil
+	}
+
+	r := append([]float64(nil), b...)
+	z := make([]float64, n)
+	M(r, z)
+	p := append([]float64(nil), z...)
+	Ap := make([]float64, n)
+	rz := vectorDot(r, z)
+
+	history := []float64{1}
+	for iterations := 1; iterations <= krylovMaxIterations; iterations++ {
+		A.mulVec(p, Ap)
+		pAp := vectorDot(p, Ap)
+		if pAp <= 0 || math.IsNaN(pAp) {
+			return nil, iterations, false, history, fmt.Errorf("matrix is not positive definite")
+		}
+		alpha := rz / pAp
+		for i := range x {
+			x[i] += alpha * p[i]
+			r[i] -= alpha * Ap[i]
+		}
+		relative := vectorNorm(r) / bNorm
+		history = append(history, relative)
+		if relative <= e {
+			return x, iterations, true, history, nil
+		}
+		M(r, z)
+		rzNew := vectorDot(r, z)
+		beta := rzNew / rz
+		rz = rzNew
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+	return x, krylovMaxIterations, false, history, nil
+}
+
+// solvePCG solves the stored SPD system with conjugate gradients once per
+// preconditioner, so the iteration counts can be compared on the same matrix.
+func solvePCG(p models.PCG) (models.PCGResult, error) {
+	system := p.MatrixIteration
+	entries, err := newMatrixEntries(system.MatrixSize, system.MatrixData)
+	if err != nil {
+		return models.PCGResult{}, err
+	}
+	if system.Error <= 0 {
+		return models.PCGResult{}, fmt.Errorf("e must be greater than 0")
+	}
+	omega := p.Omega
+	if omega == 0 {
+		omega = 1
+	}
+	if omega <= 0 || omega >= 2 {
+		return models.PCGResult{}, fmt.Errorf("omega must be between 0 and 2")
+	}
+	selected := p.Preconditioner
+	if selected == "" {
+		selected = "ic0"
+	}
+
+	A := newSparseMatrix(system.MatrixSize, entries)
+	if !A.isSymmetric() {
+		return models.PCGResult{}, fmt.Errorf("conjugate gradient requires a symmetric matrix")
+	}
+	for i, d := range A.diagonal() {
+		if d <= 0 {
+			return models.PCGResult{}, fmt.Errorf("diagonal entry %d must be positive for a positive definite matrix", i+1)
+		}
+	}
+	b, err := newRightHandSide(A, system.ConstantData)
+	if err != nil {
+		return models.PCGResult{}, err
+	}
+
+	result := models.PCGResult{Preconditioner: selected}
+	for _, name := range pcgPreconditioners {
+		var M preconditioner
+		switch name {
+		case "none":
+			M = func(r, z []float64) { copy(z, r) }
+		case "jacobi":
+			M = jacobiPreconditioner(A)
+		case "ssor":
+			M = ssorPreconditioner(A, omega)
+		case "ic0":
+			M, err = ic0Preconditioner(A)
+		}
+
+		run := models.PCGRun{Preconditioner: name}
+		var x []float64
+		if err == nil {
+			var history []float64
+			x, run.Iterations, run.Converged, history, err = conjugateGradient(A, b, M, system.Error)
+			if err == nil {
+				var final models.KrylovResult
+				final, err = krylovResult(A, b, x, run.Iterations, run.Converged, history)
+				run.RelativeResidual = final.RelativeResidual
+				run.ResidualHistory = final.ResidualHistory
+			}
+		}
+		if err != nil {
+			if name == selected {
+				return models.PCGResult{}, fmt.Errorf("%s preconditioner: %v", name, err)
+			}
+			run.Error = err.Error()
+			err = nil
+		}
+		if name == selected {
+			result.X = x
+		}
+		result.Runs = append(result.Runs, run)
+	}
+	return result, nil
+}
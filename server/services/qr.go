@@ -0,0 +1,254 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+)
+
+// qrMethods are compared in this order when no method is selected.
+var qrMethods = []string{"cgs", "mgs", "householder", "givens"}
+
+// classicalGramSchmidt orthogonalizes each column against the previous q's
+// using the original column, which loses orthogonality on ill-conditioned A.
+func classicalGramSchmidt(A [][]float64) ([][]float64, [][]float64, error) {
+	n := len(A)
+	Q, R := zeroMatrix(n), zeroMatrix(n)
+	for j := 0; j < n; j++ {
+		v := column(A, j)
+		for i := 0; i < j; i++ {
+			for k := 0; k < n; k++ {
+				R[i][j] += Q[k][i] * A[k][j]
+			}
+		}
+		for i := 0; i < j; i++ {
+			for k := 0; k < n; k++ {
+				v[k] -= R[i][j] * Q[k][i]
+			}
+		}
+		if err := normalizeColumn(Q, R, v, j); err != nil {
+			return nil, nil, err
+		}
+	}
+	return Q, R, nil
+}
+
+// modifiedGramSchmidt projects out each q from the updated column in turn.
+func modifiedGramSchmidt(A [][]float64) ([][]float64, [][]float64, error) {
+	n := len(A)
+	Q, R := zeroMatrix(n), zeroMatrix(n)
+	for j := 0; j < n; j++ {
+		v := column(A, j)
+		for i := 0; i < j; i++ {
+			for k := 0; k < n; k++ {
+				R[i][j] += Q[k][i] * v[k]
+			}
+			for k := 0; k < n; k++ {
+				v[k] -= R[i][j] * Q[k][i]
+			}
+		}
+		if err := normalizeColumn(Q, R, v, j); err != nil {
+			return nil, nil, err
+		}
+	}
+	return Q, R, nil
+}
+
+// householderQR reflects the part of each column below the diagonal onto
+// the diagonal, accumulating Q = H_1 H_2 ... H_n-1.
+func householderQR(A [][]float64) ([][]float64, [][]float64, error) {
+	n := len(A)
+	R := copyMatrix(A)
+	Q := identityMatrix(n)
+	for j := 0; j < n-1; j++ {
+		v := make([]float64, n)
+		norm := 0.0
+		for k := j; k < n; k++ {
+			v[k] = R[k][j]
+			norm += v[k] * v[k]
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			continue
+		}
+		// Choose the sign that avoids cancellation in v = x + sign(x1)||x|| e1.
+		v[j] += math.Copysign(norm, v[j])
+		vv := 0.0
+		for k := j; k < n; k++ {
+			vv += v[k] * v[k]
+		}
+		for c := 0; c < n; c++ {
+			dot := 0.0
+			for k := j; k < n; k++ {
+				dot += v[k] * R[k][c]
+			}
+			for k := j; k < n; k++ {
+				R[k][c] -= 2 * dot / vv * v[k]
+			}
+		}
+		for r := 0; r < n; r++ {
+			dot := 0.0
+			for k := j; k < n; k++ {
+				dot += Q[r][k] * v[k]
+			}
+			for k := j; k < n; k++ {
+				Q[r][k] -= 2 * dot / vv * v[k]
+			}
+		}
+		for k := j + 1; k < n; k++ {
+			R[k][j] = 0
+		}
+	}
+	return Q, R, nil
+}
+
+// givensQR zeroes the entries below the diagonal one at a time with plane
+// rotations, accumulating Q as the product of the transposed rotations.
+func givensQR(A [][]float64) ([][]float64, [][]float64, error) {
+	n := len(A)
+	R := copyMatrix(A)
+	Q := identityMatrix(n)
+	for j := 0; j < n; j++ {
+		for i := n - 1; i > j; i-- {
+			if R[i][j] == 0 {
+				continue
+			}
+			d := math.Hypot(R[i-1][j], R[i][j])
+			c, s := R[i-1][j]/d, R[i][j]/d
+			for k := 0; k < n; k++ {
+				R[i-1][k], R[i][k] = c*R[i-1][k]+s*R[i][k], -s*R[i-1][k]+c*R[i][k]
+				Q[k][i-1], Q[k][i] = c*Q[k][i-1]+s*Q[k][i], -s*Q[k][i-1]+c*Q[k][i]
+			}
+			R[i][j] = 0
+		}
+	}
+	return Q, R, nil
+}
+
+// solveQRFactorization solves Rx = Q^T b by back substitution.
+func solveQRFactorization(Q, R [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for k := 0; k < n; k++ {
+			y[i] += Q[k][i] * b[k]
+		}
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		if R[i][i] == 0 {
+			return nil, fmt.Errorf("matrix is singular")
+		}
+		sum := y[i]
+		for k := i + 1; k < n; k++ {
+			sum -= R[i][k] * x[k]
+		}
+		x[i] = sum / R[i][i]
+	}
+	return x, nil
+}
+
+// orthogonalityLoss is the Frobenius norm of Q^T Q - I.
+func orthogonalityLoss(Q [][]float64) float64 {
+	n := len(Q)
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			dot := 0.0
+			for k := 0; k < n; k++ {
+				dot += Q[k][i] * Q[k][j]
+			}
+			if i == j {
+				dot--
+			}
+			sum += dot * dot
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+func normalizeColumn(Q, R [][]float64, v []float64, j int) error {
+	R[j][j] = vectorNorm(v)
+	if R[j][j] == 0 {
+		return fmt.Errorf("matrix is singular: column %d is linearly dependent", j+1)
+	}
+	for k := range v {
+		Q[k][j] = v[k] / R[j][j]
+	}
+	return nil
+}
+
+func zeroMatrix(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	return m
+}
+
+func copyMatrix(A [][]float64) [][]float64 {
+	m := make([][]float64, len(A))
+	for i := range A {
+		m[i] = append([]float64(nil), A[i]...)
+	}
+	return m
+}
+
+func column(A [][]float64, j int) []float64 {
+	c := make([]float64, len(A))
+	for i := range A {
+		c[i] = A[i][j]
+	}
+	return c
+}
+
+// solveQR factors the stored A = QR with each selected variant and solves
+// Ax = b.
+func solveQR(p models.QR) (models.QRResult, error) {
+	A, b, err := newDenseSystem(p.Matrix.MatrixSize, p.Matrix.MatrixData, p.Matrix.ConstantData)
+	if err != nil {
+		return models.QRResult{}, err
+	}
+	methods := qrMethods
+	if p.Method != "" {
+		methods = []string{p.Method}
+	}
+
+	result := models.QRResult{}
+	for _, method := range methods {
+		var Q, R [][]float64
+		switch method {
+		case "cgs":
+			Q, R, err = classicalGramSchmidt(A)
+		case "mgs":
+			Q, R, err = modifiedGramSchmidt(A)
+		case "householder":
+			Q, R, err = householderQR(A)
+		case "givens":
+			Q, R, err = givensQR(A)
+		default:
+			return models.QRResult{}, fmt.Errorf("unknown method %q", method)
+		}
+		if err != nil {
+			return models.QRResult{}, err
+		}
+		x, err := solveQRFactorization(Q, R, b)
+		if err != nil {
+			return models.QRResult{}, err
+		}
+		r := matrixVector(A, x)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+		result.Factorizations = append(result.Factorizations, models.QRFactorization{
+			Method:            method,
+			Q:                 Q,
+			R:                 R,
+			OrthogonalityLoss: orthogonalityLoss(Q),
+			X:                 x,
+			Residual:          vectorNorm(r),
+		})
+	}
+	return result, nil
+}
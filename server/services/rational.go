@@ -0,0 +1,261 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	padeMaxOrder = derivativeMaxOrder
+	// floaterHormannMaxPoints keeps the expanded numerator and denominator
+	// meaningful; the barycentric form itself is stable for any count.
+	floaterHormannMaxPoints = 50
+)
+
+// polynomialValue evaluates coefficients given lowest degree first.
+func polynomialValue(coefficients []float64, x float64) float64 {
+	value := 0.0
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		value = value*x + coefficients[i]
+	}
+	return value
+}
+
+// rationalPoles returns the roots of the denominator, given lowest degree
+// first in powers of (x - shift), as points in x.
+func rationalPoles(denominator []float64, shift float64) []models.PolynomialRoot {
+	var descending []float64
+	for i := len(denominator) - 1; i >= 0; i-- {
+		if len(descending) > 0 || denominator[i] != 0 {
+			descending = append(descending, denominator[i])
+		}
+	}
+	poles := []models.PolynomialRoot{}
+	if len(descending) < 2 {
+		return poles
+	}
+	roots, _ := findPolynomialRoots(descending, polynomialDefaultE)
+	for _, root := range roots {
+		root.Real += shift
+		poles = append(poles, root)
+	}
+	return poles
+}
+
+// solvePade builds the [m/n] Padé approximant P(t)/Q(t), t = x - x0, whose
+// Taylor series agrees with the function's up to t^(m+n). With Q(0) = 1 the
+// denominator solves sum_{j=1}^{n} q_j c_{k-j} = -c_k for k = m+1..m+n, and
+// then p_k = sum_{j=0}^{min(k,n)} q_j c_{k-j}.
+func solvePade(p models.Pade) (models.PadeResult, error) {
+	expr, err := utils.ParseExpression(p.Function)
+	if err != nil {
+		return models.PadeResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if err := expr.CheckVariables("x"); err != nil {
+		return models.PadeResult{}, fmt.Errorf("invalid function: %v", err)
+	}
+	if p.M < 0 || p.N < 0 || p.M+p.N > padeMaxOrder {
+		return models.PadeResult{}, fmt.Errorf("m and n must not be negative and m + n must be at most %d", padeMaxOrder)
+	}
+	m, n := p.M, p.N
+	taylor, err := expr.EvaluateJet("x", p.X0, m+n)
+	if err != nil {
+		return models.PadeResult{}, err
+	}
+	for k, c := range taylor {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return models.PadeResult{}, fmt.Errorf("the Taylor coefficient %d is not defined at x0 = %v", k, p.X0)
+		}
+	}
+	c := func(k int) float64 {
+		if k < 0 {
+			return 0
+		}
+		return taylor[k]
+	}
+
+	denominator := []float64{1}
+	if n > 0 {
+		A := make([][]float64, n)
+		b := make([]float64, n)
+		for i := range A {
+			A[i] = make([]float64, n)
+			for j := range A[i] {
+				A[i][j] = c(m + i - j)
+			}
+			b[i] = -c(m + 1 + i)
+		}
+		q, err := solveLinearSystem(A, b)
+		if err != nil {
+			return models.PadeResult{}, fmt.Errorf("the [%d/%d] Padé approximant does not exist for this function, try other degrees", m, n)
+		}
+		denominator = append(denominator, q...)
+	}
+	numerator := make([]float64, m+1)
+	for k := range numerator {
+		for j := 0; j <= k && j <= n; j++ {
+			numerator[k] += denominator[j] * c(k-j)
+		}
+	}
+
+	result := models.PadeResult{
+		Taylor:      []float64(taylor),
+		Numerator:   numerator,
+		Denominator: denominator,
+		Poles:       rationalPoles(denominator, p.X0),
+	}
+	t := p.Xvalue - p.X0
+	result.Value = polynomialValue(numerator, t) / polynomialValue(denominator, t)
+	result.TaylorValue = polynomialValue(taylor, t)
+	if math.IsNaN(result.Value) || math.IsInf(result.Value, 0) {
+		return models.PadeResult{}, fmt.Errorf("xvalue = %v is a pole of the approximant", p.Xvalue)
+	}
+	if exact := expr.Func("x")(p.Xvalue); !math.IsNaN(exact) && !math.IsInf(exact, 0) {
+		result.Exact = exact
+		result.Error = math.Abs(exact - result.Value)
+		result.TaylorError = math.Abs(exact - result.TaylorValue)
+	}
+	return result, nil
+}
+
+// floaterHormannWeights returns the barycentric weights of the
+// Floater–Hormann interpolant with blending degree d through sorted nodes:
+// w_k = (-1)^(k-d) sum over i in J_k of prod_{j=i, j != k}^{i+d} 1/|x_k - x_j|,
+// where J_k = {i : max(0, k-d) <= i <= min(k, n-d)}.
+func floaterHormannWeights(xs []float64, d int) []float64 {
+	n := len(xs) - 1
+	weights := make([]float64, n+1)
+	for k := range weights {
+		for i := max(0, k-d); i <= min(k, n-d); i++ {
+			term := 1.0
+			for j := i; j <= i+d; j++ {
+				if j != k {
+					term /= math.Abs(xs[k] - xs[j])
+				}
+			}
+			weights[k] += term
+		}
+		if (k-d)%2 != 0 {
+			weights[k] = -weights[k]
+		}
+	}
+	return weights
+}
+
+// polynomialWeights returns the barycentric weights of the interpolating
+// polynomial, 1 / prod_{j != k} (x_k - x_j).
+func polynomialWeights(xs []float64) []float64 {
+	weights := make([]float64, len(xs))
+	for k := range weights {
+		weights[k] = 1
+		for j := range xs {
+			if j != k {
+				weights[k] /= xs[k] - xs[j]
+			}
+		}
+	}
+	return weights
+}
+
+// barycentric evaluates sum w_k f_k / (x - x_k) / sum w_k / (x - x_k).
+func barycentric(xs, ys, weights []float64, x float64) float64 {
+	numerator, denominator := 0.0, 0.0
+	for k := range xs {
+		if x == xs[k] {
+			return ys[k]
+		}
+		term := weights[k] / (x - xs[k])
+		numerator += term * ys[k]
+		denominator += term
+	}
+	return numerator / denominator
+}
+
+// solveFloaterHormann interpolates the points with the Floater–Hormann
+// rational function of blending degree d, which has no real poles. Each
+// point is also left out in turn and predicted from the rest, by the
+// rational interpolant and by the interpolating polynomial, so the two
+// can be compared against the data.
+func solveFloaterHormann(p models.FloaterHormann) (models.FloaterHormannResult, error) {
+	xs, ys, err := utils.ParsePoints(p.Points)
+	if err != nil {
+		return models.FloaterHormannResult{}, fmt.Errorf("invalid points: %v", err)
+	}
+	if len(xs) < 2 || len(xs) > floaterHormannMaxPoints {
+		return models.FloaterHormannResult{}, fmt.Errorf("points must have between 2 and %d entries", floaterHormannMaxPoints)
+	}
+	order := make([]int, len(xs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return xs[order[a]] < xs[order[b]] })
+	sortedX, sortedY := make([]float64, len(xs)), make([]float64, len(xs))
+	for i, j := range order {
+		sortedX[i], sortedY[i] = xs[j], ys[j]
+		if i > 0 && sortedX[i] == sortedX[i-1] {
+			return models.FloaterHormannResult{}, fmt.Errorf("x = %v appears more than once", sortedX[i])
+		}
+	}
+	xs, ys = sortedX, sortedY
+	n := len(xs) - 1
+	if p.D < 0 || p.D > n {
+		return models.FloaterHormannResult{}, fmt.Errorf("d must be between 0 and %d", n)
+	}
+
+	weights := floaterHormannWeights(xs, p.D)
+	result := models.FloaterHormannResult{
+		D:       p.D,
+		Weights: weights,
+		Value:   barycentric(xs, ys, weights, p.Xvalue),
+	}
+	result.PolynomialValue = barycentric(xs, ys, polynomialWeights(xs), p.Xvalue)
+
+	// Multiplying through by prod (x - x_j) gives the numerator
+	// sum w_k f_k prod_{j != k} (x - x_j) and the same denominator without
+	// f_k, whose degree is at most n - d.
+	result.Numerator = make([]float64, n+1)
+	denominator := make([]float64, n+1)
+	for k := range xs {
+		basis := []float64{1}
+		for j := range xs {
+			if j != k {
+				basis = polynomialProduct(basis, []float64{-xs[j], 1})
+			}
+		}
+		result.Numerator = polynomialSum(result.Numerator, polynomialScaled(basis, weights[k]*ys[k]))
+		denominator = polynomialSum(denominator, polynomialScaled(basis, weights[k]))
+	}
+	denominator = denominator[:n-p.D+1]
+	scale := 0.0
+	for _, v := range denominator {
+		scale = math.Max(scale, math.Abs(v))
+	}
+	for len(denominator) > 1 && math.Abs(denominator[len(denominator)-1]) <= 1e-12*scale {
+		denominator = denominator[:len(denominator)-1]
+	}
+	result.Denominator = denominator
+	result.Poles = rationalPoles(denominator, 0)
+
+	if n >= 2 {
+		for k := range xs {
+			restX := append(append([]float64(nil), xs[:k]...), xs[k+1:]...)
+			restY := append(append([]float64(nil), ys[:k]...), ys[k+1:]...)
+			rational := barycentric(restX, restY, floaterHormannWeights(restX, min(p.D, n-1)), xs[k])
+			polynomial := barycentric(restX, restY, polynomialWeights(restX), xs[k])
+			e := models.FloaterHormannError{
+				X:          xs[k],
+				Fx:         ys[k],
+				Rational:   math.Abs(ys[k] - rational),
+				Polynomial: math.Abs(ys[k] - polynomial),
+			}
+			result.Errors = append(result.Errors, e)
+			result.MaxError = math.Max(result.MaxError, e.Rational)
+			result.PolynomialMaxError = math.Max(result.PolynomialMaxError, e.Polynomial)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	sorMaxIterations = 1000
+	// sorMaxSize bounds the dense system, since the omega sweep below runs
+	// up to 19 * sorMaxIterations sweeps of n^2 work each.
+	sorMaxSize              = 100
+	spectralMaxIterations   = 1000
+	spectralRadiusTolerance = 1e-10
+)
+
+// newDenseSystem parses a row-major matrix and its constants.
+func newDenseSystem(size int, matrixData, constantData string) ([][]float64, []float64, error) {
+	if size < 1 {
+		return nil, nil, fmt.Errorf("matrix_size must be at least 1")
+	}
+	values, err := utils.ParseFloatList(matrixData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid matrix_data: %v", err)
+	}
+	if len(values) != size*size {
+		return nil, nil, fmt.Errorf("matrix_data must have %d values", size*size)
+	}
+	b, err := utils.ParseFloatList(constantData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid constant_data: %v", err)
+	}
+	if len(b) != size {
+		return nil, nil, fmt.Errorf("constant_data must have %d values", size)
+	}
+	A := make([][]float64, size)
+	for i := range A {
+		A[i] = values[i*size : (i+1)*size]
+	}
+	return A, b, nil
+}
+
+// jacobiSpectralRadius estimates the spectral radius of T = -D^-1 (L + U) by
+// power iteration. Two steps are taken at a time, because the eigenvalues of
+// T often come in +/- pairs and a single step would oscillate.
+func jacobiSpectralRadius(A [][]float64) float64 {
+	n := len(A)
+	apply := func(v []float64) []float64 {
+		w := make([]float64, n)
+		for i := range A {
+			sum := 0.0
+			for j := range A[i] {
+				if j != i {
+					sum -= A[i][j] * v[j]
+				}
+			}
+			w[i] = sum / A[i][i]
+		}
+		return w
+	}
+
+	v := make([]float64, n)
+	for i := range v {
+		// A non-symmetric start avoids being orthogonal to the dominant mode.
+		v[i] = 1 + float64(i)/float64(n)
+	}
+	v = vectorScaled(v, 1/vectorNorm(v))
+	rho := 0.0
+	for k := 0; k < spectralMaxIterations; k++ {
+		w := apply(apply(v))
+		norm := vectorNorm(w)
+		if norm == 0 {
+			return 0
+		}
+		estimate := math.Sqrt(norm)
+		v = vectorScaled(w, 1/norm)
+		if math.Abs(estimate-rho) <= spectralRadiusTolerance*math.Max(estimate, 1) {
+			return estimate
+		}
+		rho = estimate
+	}
+	return rho
+}
+
+// relaxationSweep applies one forward (or backward) SOR sweep in place.
+func relaxationSweep(A [][]float64, b, x []float64, omega float64, backward bool) {
+	n := len(b)
+	for k := 0; k < n; k++ {
+		i := k
+		if backward {
+			i = n - 1 - k
+		}
+		sum := b[i]
+		for j := range A[i] {
+			if j != i {
+				sum -= A[i][j] * x[j]
+			}
+		}
+		x[i] = (1-omega)*x[i] + omega*sum/A[i][i]
+	}
+}
+
+// relaxationError is the largest relative change max |(x_new - x_old) / x_new|.
+func relaxationError(xOld, xNew []float64) float64 {
+	largest := 0.0
+	for i := range xNew {
+		change := math.Abs(xNew[i] - xOld[i])
+		if xNew[i] != 0 {
+			change /= math.Abs(xNew[i])
+		}
+		largest = math.Max(largest, change)
+	}
+	return largest
+}
+
+// relax iterates SOR (or SSOR, a forward then a backward sweep) from x0 = 0
+// until the relative change is below e, logging every iteration when asked.
+func relax(A [][]float64, b []float64, omega, e float64, symmetric, logged bool) ([]float64, int, bool, []models.SORIteration) {
+	x := make([]float64, len(b))
+	var log []models.SORIteration
+	for it := 1; it <= sorMaxIterations; it++ {
+		xOld := append([]float64(nil), x...)
+		relaxationSweep(A, b, x, omega, false)
+		if symmetric {
+			relaxationSweep(A, b, x, omega, true)
+		}
+		ea := relaxationError(xOld, x)
+		if math.IsNaN(ea) || math.IsInf(ea, 0) {
+			return xOld, it, false, log
+		}
+		if logged {
+			log = append(log, models.SORIteration{
+				Iteration: it,
+				X:         append([]float64(nil), x...),
+				Error:     ea,
+			})
+		}
+		if ea < e {
+			return x, it, true, log
+		}
+	}
+	return x, sorMaxIterations, false, log
+}
+
+// solveSOR runs successive over-relaxation (or its symmetric variant) on the
+// stored system with the given omega, or for SOR with the optimal omega
+// 2 / (1 + sqrt(1 - rho^2)) derived from the Jacobi spectral radius rho, and
+// compares a sweep of omega values.
+func solveSOR(p models.SOR) (models.SORResult, error) {
+	system := p.MatrixIteration
+	if system.MatrixSize > sorMaxSize {
+		return models.SORResult{}, fmt.Errorf("matrix_size must be at most %d", sorMaxSize)
+	}
+	A, b, err := newDenseSystem(system.MatrixSize, system.MatrixData, system.ConstantData)
+	if err != nil {
+		return models.SORResult{}, err
+	}
+	if system.Error <= 0 {
+		return models.SORResult{}, fmt.Errorf("e must be greater than 0")
+	}
+	for i := range A {
+		if A[i][i] == 0 {
+			return models.SORResult{}, fmt.Errorf("diagonal entry %d must not be zero", i+1)
+		}
+	}
+	method := p.Method
+	if method == "" {
+		method = "sor"
+	}
+	symmetric := method == "ssor"
+
+	result := models.SORResult{Method: method}
+	result.SpectralRadius = jacobiSpectralRadius(A)
+	if result.SpectralRadius < 1 {
+		result.OptimalOmega = 2 / (1 + math.Sqrt(1-result.SpectralRadius*result.SpectralRadius))
+	}
+
+	omega := p.Omega
+	if p.Auto {
+		// The optimum applies to SOR on consistently ordered matrices; SSOR
+		// has no such closed form in terms of the Jacobi spectral radius.
+		if symmetric {
+			return models.SORResult{}, fmt.Errorf("auto omega is only available for sor")
+		}
+		if result.SpectralRadius >= 1 {
+			return models.SORResult{}, fmt.Errorf("auto omega needs a convergent Jacobi iteration, but its spectral radius is %g", result.SpectralRadius)
+		}
+		omega = result.OptimalOmega
+	}
+	if omega <= 0 || omega >= 2 {
+		return models.SORResult{}, fmt.Errorf("omega must be between 0 and 2")
+	}
+	result.Omega = omega
+
+	result.X, _, result.Converged, result.Iterations = relax(A, b, omega, system.Error, symmetric, true)
+
+	for k := 1; k <= 19; k++ {
+		w := float64(k) / 10
+		_, iterations, converged, _ := relax(A, b, w, system.Error, symmetric, false)
+		result.Sweep = append(result.Sweep, models.OmegaSweep{
+			Omega:      w,
+			Iterations: iterations,
+			Converged:  converged,
+		})
+	}
+	return result, nil
+}
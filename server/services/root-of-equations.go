@@ -25,6 +25,16 @@ type RootService interface {
 	CreateNewtonRaphson(c *fiber.Ctx) error
 	GetSecant(c *fiber.Ctx) error
 	CreateSecant(c *fiber.Ctx) error
+	GetPolynomialRoots(c *fiber.Ctx) error
+	CreatePolynomialRoots(c *fiber.Ctx) error
+	GetBrent(c *fiber.Ctx) error
+	CreateBrent(c *fiber.Ctx) error
+	GetRidders(c *fiber.Ctx) error
+	CreateRidders(c *fiber.Ctx) error
+	GetITP(c *fiber.Ctx) error
+	CreateITP(c *fiber.Ctx) error
+	GetIntervalNewton(c *fiber.Ctx) error
+	CreateIntervalNewton(c *fiber.Ctx) error
 }
 
 func NewRootService(db *gorm.DB) RootService {
@@ -130,12 +140,23 @@ func (s *RootServiceImpl) GetBisection(c *fiber.Ctx) error {
 			Message: "Error fetching bisection data",
 		})
 	}
+
+	if bisection.Precision > 0 {
+		result, err := precisionBracket(bisection.Equation, bisection.Xl, bisection.Xr, bisection.E, bisection.Precision, false)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		bisection.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(bisection)
 }
 
 // @Tags Bisection
 // @Summary Create Bisection Method Result
-// @Description Create the Bisection method
+// @Description Create the Bisection method; with precision (bits) set, it is also run in big.Float and compared with float64
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqBisection true "Request Body"
@@ -153,10 +174,21 @@ func (s *RootServiceImpl) CreateBisection(c *fiber.Ctx) error {
 	}
 
 	bisection := models.Bisection{
-		Equation: req.Equation,
-		Xl:       req.Xl,
-		Xr:       req.Xr,
-		E:        req.E,
+		Equation:  req.Equation,
+		Xl:        req.Xl,
+		Xr:        req.Xr,
+		E:         req.E,
+		Precision: req.Precision,
+	}
+
+	if bisection.Precision > 0 {
+		result, err := precisionBracket(bisection.Equation, bisection.Xl, bisection.Xr, bisection.E, bisection.Precision, false)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		bisection.Result = result
 	}
 
 	if err := s.DB.Create(&bisection).Error; err != nil {
@@ -199,12 +231,23 @@ func (s *RootServiceImpl) GetFalsePosition(c *fiber.Ctx) error {
 			Message: "Error fetching false position data",
 		})
 	}
+
+	if falsePosition.Precision > 0 {
+		result, err := precisionBracket(falsePosition.Equation, falsePosition.Xl, falsePosition.Xr, falsePosition.E, falsePosition.Precision, true)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		falsePosition.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(falsePosition)
 }
 
 // @Tags FalsePosition
 // @Summary Create FalsePosition Method Result
-// @Description Create the FalsePosition method
+// @Description Create the FalsePosition method; with precision (bits) set, it is also run in big.Float and compared with float64
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqFalsePosition true "Request Body"
@@ -222,10 +265,21 @@ func (s *RootServiceImpl) CreateFalsePosition(c *fiber.Ctx) error {
 	}
 
 	falsePosition := models.FalsePosition{
-		Equation: req.Equation,
-		Xl:       req.Xl,
-		Xr:       req.Xr,
-		E:        req.E,
+		Equation:  req.Equation,
+		Xl:        req.Xl,
+		Xr:        req.Xr,
+		E:         req.E,
+		Precision: req.Precision,
+	}
+
+	if falsePosition.Precision > 0 {
+		result, err := precisionBracket(falsePosition.Equation, falsePosition.Xl, falsePosition.Xr, falsePosition.E, falsePosition.Precision, true)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		falsePosition.Result = result
 	}
 
 	if err := s.DB.Create(&falsePosition).Error; err != nil {
@@ -268,12 +322,22 @@ func (s *RootServiceImpl) GetOnePoint(c *fiber.Ctx) error {
 			Message: "Error fetching one point data",
 		})
 	}
+	if onePoint.Precision > 0 {
+		result, err := precisionOpen(onePoint.Equation, "one-point", onePointStart, 0, onePoint.E, onePoint.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		onePoint.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(onePoint)
 }
 
 // @Tags OnePoint
 // @Summary Create OnePoint Method Result
-// @Description Create the OnePoint method
+// @Description Create the OnePoint method; with precision (bits) set, it is also run in big.Float and compared with float64
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqOnePoint true "Request Body"
@@ -291,8 +355,19 @@ func (s *RootServiceImpl) CreateOnePoint(c *fiber.Ctx) error {
 	}
 
 	onePoint := models.OnePoint{
-		Equation: req.Equation,
-		E:        req.E,
+		Equation:  req.Equation,
+		E:         req.E,
+		Precision: req.Precision,
+	}
+
+	if onePoint.Precision > 0 {
+		result, err := precisionOpen(onePoint.Equation, "one-point", onePointStart, 0, onePoint.E, onePoint.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		onePoint.Result = result
 	}
 
 	if err := s.DB.Create(&onePoint).Error; err != nil {
@@ -335,12 +410,22 @@ func (s *RootServiceImpl) GetNewtonRaphson(c *fiber.Ctx) error {
 			Message: "Error fetching newtonRapson data",
 		})
 	}
+	if newtonRaphson.Precision > 0 {
+		result, err := precisionOpen(newtonRaphson.Equation, "newton-raphson", newtonRaphson.X0, 0, newtonRaphson.E, newtonRaphson.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		newtonRaphson.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(newtonRaphson)
 }
 
 // @Tags NewtonRaphson
 // @Summary Create NewtonRaphson Method Result
-// @Description Create the NewtonRaphson method
+// @Description Create the NewtonRaphson method; with precision (bits) set, it is also run in big.Float and compared with float64
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqNewtonRaphson true "Request Body"
@@ -358,9 +443,20 @@ func (s *RootServiceImpl) CreateNewtonRaphson(c *fiber.Ctx) error {
 	}
 
 	newtonRaphson := models.NewtonRaphson{
-		Equation: req.Equation,
-		X0:       req.X0,
-		E:        req.E,
+		Equation:  req.Equation,
+		X0:        req.X0,
+		E:         req.E,
+		Precision: req.Precision,
+	}
+
+	if newtonRaphson.Precision > 0 {
+		result, err := precisionOpen(newtonRaphson.Equation, "newton-raphson", newtonRaphson.X0, 0, newtonRaphson.E, newtonRaphson.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		newtonRaphson.Result = result
 	}
 
 	if err := s.DB.Create(&newtonRaphson).Error; err != nil {
@@ -403,12 +499,22 @@ func (s *RootServiceImpl) GetSecant(c *fiber.Ctx) error {
 			Message: "Error fetching secant data",
 		})
 	}
+	if secant.Precision > 0 {
+		result, err := precisionOpen(secant.Equation, "secant", secant.X0, secant.X1, secant.E, secant.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		secant.Result = result
+	}
+
 	return c.Status(fiber.StatusOK).JSON(secant)
 }
 
 // @Tags Secant
 // @Summary Create Secantn Method Result
-// @Description Create the Secant method
+// @Description Create the Secant method; with precision (bits) set, it is also run in big.Float and compared with float64
 // @Accept json
 // @Produce json
 // @Param req body validations.ReqSecant true "Request Body"
@@ -426,10 +532,21 @@ func (s *RootServiceImpl) CreateSecant(c *fiber.Ctx) error {
 	}
 
 	secant := models.Secant{
-		Equation: req.Equation,
-		X0:       req.X0,
-		X1:       req.X1,
-		E:        req.E,
+		Equation:  req.Equation,
+		X0:        req.X0,
+		X1:        req.X1,
+		E:         req.E,
+		Precision: req.Precision,
+	}
+
+	if secant.Precision > 0 {
+		result, err := precisionOpen(secant.Equation, "secant", secant.X0, secant.X1, secant.E, secant.Precision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+		secant.Result = result
 	}
 
 	if err := s.DB.Create(&secant).Error; err != nil {
@@ -440,3 +557,451 @@ func (s *RootServiceImpl) CreateSecant(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusCreated).JSON(secant)
 }
+
+// @Tags PolynomialRoots
+// @Summary Get Polynomial Roots Result
+// @Description Get all complex roots of the stored polynomial by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "PolynomialRoots ID"
+// @Success 200 {object} models.PolynomialRoots
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/root-of-equations/polynomial/{id} [get]
+func (s *RootServiceImpl) GetPolynomialRoots(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var polynomialRoots models.PolynomialRoots
+
+	if err := s.DB.First(&polynomialRoots, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Polynomial roots data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching polynomial roots data",
+		})
+	}
+
+	result, err := solvePolynomialRoots(polynomialRoots)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	polynomialRoots.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(polynomialRoots)
+}
+
+// @Tags PolynomialRoots
+// @Summary Create Polynomial Roots Result
+// @Description Find all complex roots of a polynomial given as an equation in x or as coefficients (highest degree first)
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqPolynomialRoots true "Request Body"
+// @Success 201 {object} models.PolynomialRoots
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/root-of-equations/polynomial [post]
+func (s *RootServiceImpl) CreatePolynomialRoots(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqPolynomialRoots)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	polynomialRoots := models.PolynomialRoots{
+		Equation:     req.Equation,
+		Coefficients: req.Coefficients,
+		E:            req.E,
+	}
+
+	result, err := solvePolynomialRoots(polynomialRoots)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&polynomialRoots).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	polynomialRoots.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(polynomialRoots)
+}
+
+// @Tags Brent
+// @Summary Get Brent Method Result
+// @Description Get the Brent's method result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Brent ID"
+// @Success 200 {object} models.Brent
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/root-of-equations/brent/{id} [get]
+func (s *RootServiceImpl) GetBrent(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var brent models.Brent
+
+	if err := s.DB.First(&brent, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Brent data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching brent data",
+		})
+	}
+
+	result, err := solveBrent(brent)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	brent.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(brent)
+}
+
+// @Tags Brent
+// @Summary Create Brent Method Result
+// @Description Solve the equation on the bracket [xl, xr] with the Brent's method, stopping when the relative approximate error (%) is below e
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBisection true "Request Body"
+// @Success 201 {object} models.Brent
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/root-of-equations/brent [post]
+func (s *RootServiceImpl) CreateBrent(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBisection)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	if req.Precision != 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision is only supported by bisection, false position, one-point, newton-raphson and secant",
+		})
+	}
+
+	brent := models.Brent{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+	}
+
+	result, err := solveBrent(brent)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&brent).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	brent.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(brent)
+}
+
+// @Tags Ridders
+// @Summary Get Ridders Method Result
+// @Description Get the Ridders' method result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Ridders ID"
+// @Success 200 {object} models.Ridders
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/root-of-equations/ridders/{id} [get]
+func (s *RootServiceImpl) GetRidders(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var ridders models.Ridders
+
+	if err := s.DB.First(&ridders, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Ridders data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching ridders data",
+		})
+	}
+
+	result, err := solveRidders(ridders)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	ridders.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(ridders)
+}
+
+// @Tags Ridders
+// @Summary Create Ridders Method Result
+// @Description Solve the equation on the bracket [xl, xr] with the Ridders' method, stopping when the relative approximate error (%) is below e
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBisection true "Request Body"
+// @Success 201 {object} models.Ridders
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/root-of-equations/ridders [post]
+func (s *RootServiceImpl) CreateRidders(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBisection)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	if req.Precision != 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision is only supported by bisection, false position, one-point, newton-raphson and secant",
+		})
+	}
+
+	ridders := models.Ridders{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+	}
+
+	result, err := solveRidders(ridders)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&ridders).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	ridders.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(ridders)
+}
+
+// @Tags ITP
+// @Summary Get ITP Method Result
+// @Description Get the ITP method result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "ITP ID"
+// @Success 200 {object} models.ITP
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/root-of-equations/itp/{id} [get]
+func (s *RootServiceImpl) GetITP(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var itp models.ITP
+
+	if err := s.DB.First(&itp, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "ITP data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching ITP data",
+		})
+	}
+
+	result, err := solveITP(itp)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	itp.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(itp)
+}
+
+// @Tags ITP
+// @Summary Create ITP Method Result
+// @Description Solve the equation on the bracket [xl, xr] with the ITP method, stopping when the relative approximate error (%) is below e
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBisection true "Request Body"
+// @Success 201 {object} models.ITP
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/root-of-equations/itp [post]
+func (s *RootServiceImpl) CreateITP(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBisection)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	if req.Precision != 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision is only supported by bisection, false position, one-point, newton-raphson and secant",
+		})
+	}
+
+	itp := models.ITP{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+	}
+
+	result, err := solveITP(itp)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&itp).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	itp.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(itp)
+}
+
+// @Tags Interval Newton
+// @Summary Get Interval Newton Result
+// @Description Get the interval Newton result by ID
+// @Accept json
+// @Produce json
+// @Param id path string true "Interval Newton ID"
+// @Success 200 {object} models.IntervalNewton
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Failure 404 {object} utils.ErrorResponse "Not Found"
+// @Router /numerical-method/root-of-equations/interval-newton/{id} [get]
+func (s *RootServiceImpl) GetIntervalNewton(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "ID parameter is required",
+		})
+	}
+
+	var intervalNewton models.IntervalNewton
+
+	if err := s.DB.First(&intervalNewton, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(utils.ErrorResponse{
+				Message: "Interval newton data not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Error fetching interval newton data",
+		})
+	}
+
+	result, err := solveIntervalNewton(intervalNewton)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	intervalNewton.Result = result
+
+	return c.Status(fiber.StatusOK).JSON(intervalNewton)
+}
+
+// @Tags Interval Newton
+// @Summary Create Interval Newton Result
+// @Description Enclose every root of the equation in [xl, xr] with interval Newton steps and bisection, refining each enclosure until its relative width (%) is below e, or prove that there is no root
+// @Accept json
+// @Produce json
+// @Param req body validations.ReqBisection true "Request Body"
+// @Success 201 {object} models.IntervalNewton
+// @Failure 400 {object} utils.ErrorResponse "Bad Request"
+// @Router /numerical-method/root-of-equations/interval-newton [post]
+func (s *RootServiceImpl) CreateIntervalNewton(c *fiber.Ctx) error {
+	req, ok := c.Locals("req").(validations.ReqBisection)
+
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "local req not found",
+		})
+	}
+
+	if req.Precision != 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision is only supported by bisection, false position, one-point, newton-raphson and secant",
+		})
+	}
+
+	intervalNewton := models.IntervalNewton{
+		Equation: req.Equation,
+		Xl:       req.Xl,
+		Xr:       req.Xr,
+		E:        req.E,
+	}
+
+	result, err := solveIntervalNewton(intervalNewton)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.DB.Create(&intervalNewton).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(utils.ErrorResponse{
+			Message: "Failed to save data to the database",
+		})
+	}
+	intervalNewton.Result = result
+
+	return c.Status(fiber.StatusCreated).JSON(intervalNewton)
+}
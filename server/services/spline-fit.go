@@ -0,0 +1,376 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/BaimhonS/numerical-method/models"
+	"github.com/BaimhonS/numerical-method/utils"
+)
+
+const (
+	splineFitMaxPoints  = 200
+	splineFitMaxDegree  = 5
+	splineDefaultDegree = 3
+	// GCV is scanned over log10 lambda in these steps relative to the
+	// ratio of the data and penalty scales, then refined by golden section.
+	gcvLowerExponent  = -12.0
+	gcvUpperExponent  = 6.0
+	gcvStep           = 0.5
+	gcvGoldenSections = 30
+)
+
+// bsplineBasis returns the values of every B-spline of the given degree on
+// the clamped knot vector, or of their derivative of the given order, at x.
+// Outside the knots the end polynomial pieces are extended.
+func bsplineBasis(knots []float64, degree int, x float64, derivative int) []float64 {
+	count := len(knots) - degree - 1
+	values := make([]float64, count)
+	if derivative > degree {
+		return values
+	}
+	if derivative > 0 {
+		// B'_{i,p} = p (B_{i,p-1} / (t_{i+p} - t_i) - B_{i+1,p-1} / (t_{i+p+1} - t_{i+1})).
+		lower := bsplineBasis(knots, degree-1, x, derivative-1)
+		for i := range values {
+			if d := knots[i+degree] - knots[i]; d > 0 {
+				values[i] += float64(degree) * lower[i] / d
+			}
+			if d := knots[i+degree+1] - knots[i+1]; d > 0 {
+				values[i] -= float64(degree) * lower[i+1] / d
+			}
+		}
+		return values
+	}
+
+	// Find the non-empty span [t_s, t_s+1) holding x, clamped to the ends,
+	// then build the degree + 1 B-splines that are non-zero on it.
+	first, last := knots[0], knots[len(knots)-1]
+	clamped := math.Min(math.Max(x, first), last)
+	s := 0
+	for s+1 < len(knots)-1 && knots[s+1] <= clamped && knots[s+1] < last {
+		s++
+	}
+	local := make([]float64, degree+1)
+	left, right := make([]float64, degree+1), make([]float64, degree+1)
+	local[0] = 1
+	for j := 1; j <= degree; j++ {
+		left[j], right[j] = x-knots[s+1-j], knots[s+j]-x
+		saved := 0.0
+		for r := 0; r < j; r++ {
+			temp := local[r] / (right[r+1] + left[j-r])
+			local[r] = saved + right[r+1]*temp
+			saved = left[j-r] * temp
+		}
+		local[j] = saved
+	}
+	for r, v := range local {
+		values[s-degree+r] = v
+	}
+	return values
+}
+
+// splineFitData parses the points for a spline fit.
+func splineFitData(points string) ([]float64, []float64, error) {
+	xs, ys, err := utils.ParsePoints(points)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid points: %v", err)
+	}
+	if len(xs) > splineFitMaxPoints {
+		return nil, nil, fmt.Errorf("points must have at most %d entries", splineFitMaxPoints)
+	}
+	return xs, ys, nil
+}
+
+// bandCholesky factors the symmetric positive definite matrix A = L L^T,
+// whose entries vanish more than width places from the diagonal.
+func bandCholesky(A [][]float64, width int) ([][]float64, error) {
+	n := len(A)
+	L := zeroMatrix(n)
+	for j := 0; j < n; j++ {
+		sum := A[j][j]
+		for k := max(0, j-width); k < j; k++ {
+			sum -= L[j][k] * L[j][k]
+		}
+		if sum <= 1e-14*A[j][j] {
+			return nil, fmt.Errorf("matrix is not positive definite")
+		}
+		L[j][j] = math.Sqrt(sum)
+		for i := j + 1; i <= min(n-1, j+width); i++ {
+			s := A[i][j]
+			for k := max(0, i-width); k < j; k++ {
+				s -= L[i][k] * L[j][k]
+			}
+			L[i][j] = s / L[j][j]
+		}
+	}
+	return L, nil
+}
+
+// bandCholeskySolve solves L L^T x = b for a factor from bandCholesky.
+func bandCholeskySolve(L [][]float64, width int, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for k := max(0, i-width); k < i; k++ {
+			sum -= L[i][k] * x[k]
+		}
+		x[i] = sum / L[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for k := i + 1; k <= min(n-1, i+width); k++ {
+			sum -= L[k][i] * x[k]
+		}
+		x[i] = sum / L[i][i]
+	}
+	return x
+}
+
+// penalizedSplineFit solves (B^T B + lambda Omega) c = B^T y for the basis
+// matrix B of splines of the given degree and returns the fit with its
+// effective degrees of freedom, tr(B (B^T B + lambda Omega)^-1 B^T), and
+// GCV score n RSS / (n - edf)^2. Both matrices are banded, since a
+// B-spline overlaps only the degree splines either side of it.
+func penalizedSplineFit(basis, gram, penalty [][]float64, degree int, ys []float64, lambda float64) (models.SplineFitResult, error) {
+	n, m := len(basis), len(gram)
+	A := make([][]float64, m)
+	for i := range A {
+		A[i] = make([]float64, m)
+		for j := range A[i] {
+			A[i][j] = gram[i][j]
+			if penalty != nil {
+				A[i][j] += lambda * penalty[i][j]
+			}
+		}
+	}
+	rhs := make([]float64, m)
+	for k, row := range basis {
+		for i, b := range row {
+			rhs[i] += b * ys[k]
+		}
+	}
+	L, err := bandCholesky(A, degree)
+	if err != nil {
+		return models.SplineFitResult{}, fmt.Errorf("the fit is not determined, too few points lie between the knots")
+	}
+	result := models.SplineFitResult{
+		Lambda:       lambda,
+		Coefficients: bandCholeskySolve(L, degree, rhs),
+		Fitted:       make([]float64, n),
+		Residuals:    make([]float64, n),
+	}
+	for k, row := range basis {
+		result.Fitted[k] = vectorDot(row, result.Coefficients)
+		result.Residuals[k] = ys[k] - result.Fitted[k]
+		result.SumSquaredResiduals += result.Residuals[k] * result.Residuals[k]
+	}
+	column := make([]float64, m)
+	for j := range gram {
+		for i := range gram {
+			column[i] = gram[i][j]
+		}
+		result.EffectiveDegreesOfFreedom += bandCholeskySolve(L, degree, column)[j]
+	}
+	// Close to interpolation, rounding in the trace dominates n - edf, so
+	// GCV is left at 0 (undefined) there.
+	if float64(n)-result.EffectiveDegreesOfFreedom > 1e-3*float64(n) {
+		result.GCV = float64(n) * result.SumSquaredResiduals / math.Pow(float64(n)-result.EffectiveDegreesOfFreedom, 2)
+	}
+	for _, v := range result.Coefficients {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return models.SplineFitResult{}, fmt.Errorf("the fit is not determined, too few points lie between the knots")
+		}
+	}
+	return result, nil
+}
+
+// basisMatrices returns the rows B(x_k) and the Gram matrix B^T B.
+func basisMatrices(knots []float64, degree int, xs []float64) ([][]float64, [][]float64) {
+	basis := make([][]float64, len(xs))
+	for k, x := range xs {
+		basis[k] = bsplineBasis(knots, degree, x, 0)
+	}
+	m := len(knots) - degree - 1
+	gram := make([][]float64, m)
+	for i := range gram {
+		gram[i] = make([]float64, m)
+		for j := range gram[i] {
+			for _, row := range basis {
+				gram[i][j] += row[i] * row[j]
+			}
+		}
+	}
+	return basis, gram
+}
+
+// solveBSplineRegression fits a spline of the given degree with the given
+// interior knots, or with equally spaced ones, by least squares in the
+// B-spline basis.
+func solveBSplineRegression(p models.BSplineRegression) (models.SplineFitResult, error) {
+	xs, ys, err := splineFitData(p.Points)
+	if err != nil {
+		return models.SplineFitResult{}, err
+	}
+	degree := p.Degree
+	if degree == 0 {
+		degree = splineDefaultDegree
+	}
+	if degree < 1 || degree > splineFitMaxDegree {
+		return models.SplineFitResult{}, fmt.Errorf("degree must be between 1 and %d", splineFitMaxDegree)
+	}
+	a, b := xs[0], xs[0]
+	for _, x := range xs {
+		a, b = math.Min(a, x), math.Max(b, x)
+	}
+	if a == b {
+		return models.SplineFitResult{}, fmt.Errorf("points must have at least 2 different x values")
+	}
+
+	var interior []float64
+	if p.Knots != "" {
+		if interior, err = utils.ParseFloatList(p.Knots); err != nil {
+			return models.SplineFitResult{}, fmt.Errorf("invalid knots: %v", err)
+		}
+		for i, t := range interior {
+			if t <= a || t >= b {
+				return models.SplineFitResult{}, fmt.Errorf("knots must lie strictly between %v and %v", a, b)
+			}
+			if i > 0 && t <= interior[i-1] {
+				return models.SplineFitResult{}, fmt.Errorf("knots must be strictly increasing")
+			}
+		}
+	} else {
+		// Each segment adds a coefficient, so the bound is checked before
+		// any knots are built.
+		if p.Segments < 0 || p.Segments > len(xs)-degree {
+			return models.SplineFitResult{}, fmt.Errorf("segments must be between 1 and %d, the number of points minus the degree", max(len(xs)-degree, 1))
+		}
+		segments := max(p.Segments, 1)
+		for i := 1; i < segments; i++ {
+			interior = append(interior, a+(b-a)*float64(i)/float64(segments))
+		}
+	}
+	var knots []float64
+	for i := 0; i <= degree; i++ {
+		knots = append(knots, a)
+	}
+	knots = append(knots, interior...)
+	for i := 0; i <= degree; i++ {
+		knots = append(knots, b)
+	}
+	if m := len(knots) - degree - 1; m > len(xs) {
+		return models.SplineFitResult{}, fmt.Errorf("the spline has %d coefficients but there are only %d points", m, len(xs))
+	}
+
+	basis, gram := basisMatrices(knots, degree, xs)
+	result, err := penalizedSplineFit(basis, gram, nil, degree, ys, 0)
+	if err != nil {
+		return models.SplineFitResult{}, err
+	}
+	result.Degree, result.Knots = degree, knots
+	result.Prediction = vectorDot(bsplineBasis(knots, degree, p.Xvalue, 0), result.Coefficients)
+	return result, nil
+}
+
+// solveSmoothingSpline fits the cubic smoothing spline, which minimizes
+// sum (y_k - f(x_k))^2 + lambda int (d^2f/dx^2)^2 dx, in the B-spline
+// basis with a knot at every distinct x. Lambda = 0 chooses lambda by minimizing GCV.
+func solveSmoothingSpline(p models.SmoothingSpline) (models.SplineFitResult, error) {
+	xs, ys, err := splineFitData(p.Points)
+	if err != nil {
+		return models.SplineFitResult{}, err
+	}
+	if p.Lambda < 0 {
+		return models.SplineFitResult{}, fmt.Errorf("lambda cannot be negative")
+	}
+	seen := map[float64]bool{}
+	var breaks []float64
+	for _, x := range xs {
+		if !seen[x] {
+			seen[x] = true
+			breaks = append(breaks, x)
+		}
+	}
+	if len(breaks) < 3 {
+		return models.SplineFitResult{}, fmt.Errorf("points must have at least 3 different x values")
+	}
+	sort.Float64s(breaks)
+	const degree = 3
+	knots := append([]float64{breaks[0], breaks[0], breaks[0]}, breaks...)
+	knots = append(knots, breaks[len(breaks)-1], breaks[len(breaks)-1], breaks[len(breaks)-1])
+	basis, gram := basisMatrices(knots, degree, xs)
+
+	// B'' is linear on each interval, so two Gauss points integrate the
+	// penalty exactly.
+	m := len(gram)
+	penalty := make([][]float64, m)
+	for i := range penalty {
+		penalty[i] = make([]float64, m)
+	}
+	for k := 0; k+1 < len(breaks); k++ {
+		mid, half := (breaks[k]+breaks[k+1])/2, (breaks[k+1]-breaks[k])/2
+		for _, g := range []float64{-1 / math.Sqrt(3), 1 / math.Sqrt(3)} {
+			second := bsplineBasis(knots, degree, mid+half*g, 2)
+			for i := range penalty {
+				for j := range penalty {
+					penalty[i][j] += half * second[i] * second[j]
+				}
+			}
+		}
+	}
+
+	var result models.SplineFitResult
+	if p.Lambda > 0 {
+		if result, err = penalizedSplineFit(basis, gram, penalty, degree, ys, p.Lambda); err != nil {
+			return models.SplineFitResult{}, err
+		}
+	} else {
+		gramTrace, penaltyTrace := 0.0, 0.0
+		for i := range gram {
+			gramTrace += gram[i][i]
+			penaltyTrace += penalty[i][i]
+		}
+		scale := gramTrace / penaltyTrace
+		best := math.Inf(1)
+		fit := func(exponent float64) float64 {
+			candidate, err := penalizedSplineFit(basis, gram, penalty, degree, ys, scale*math.Pow(10, exponent))
+			if err != nil || candidate.GCV == 0 {
+				return math.Inf(1)
+			}
+			if candidate.GCV < best {
+				best, result = candidate.GCV, candidate
+			}
+			return candidate.GCV
+		}
+		bestExponent := gcvLowerExponent
+		for u := gcvLowerExponent; u <= gcvUpperExponent; u += gcvStep {
+			if fit(u) <= best {
+				bestExponent = u
+			}
+		}
+		if math.IsInf(best, 1) {
+			return models.SplineFitResult{}, fmt.Errorf("GCV could not choose lambda for these points")
+		}
+		lo, hi := bestExponent-gcvStep, bestExponent+gcvStep
+		ratio := (math.Sqrt(5) - 1) / 2
+		u1, u2 := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+		f1, f2 := fit(u1), fit(u2)
+		for i := 0; i < gcvGoldenSections; i++ {
+			if f1 < f2 {
+				hi, u2, f2 = u2, u1, f1
+				u1 = hi - ratio*(hi-lo)
+				f1 = fit(u1)
+			} else {
+				lo, u1, f1 = u1, u2, f2
+				u2 = lo + ratio*(hi-lo)
+				f2 = fit(u2)
+			}
+		}
+	}
+	result.Degree, result.Knots = degree, knots
+	result.Prediction = vectorDot(bsplineBasis(knots, degree, p.Xvalue, 0), result.Coefficients)
+	return result, nil
+}
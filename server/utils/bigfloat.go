@@ -0,0 +1,521 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// bigGuardBits are carried through elementary functions so that the result
+// is correct to the requested precision after rounding.
+const bigGuardBits = 32
+
+// bigMaxPrec caps the working precision, which grows with the size of the
+// argument when reducing an angle, so that a huge argument is an error
+// rather than a computation that does not finish.
+const bigMaxPrec = 1 << 16
+
+// EvaluateBig computes the value of the expression in big.Float arithmetic
+// with prec bits of mantissa. Number literals are re-read from their text,
+// so "0.1" is not first rounded to float64. A panic inside math/big, such
+// as big.ErrNaN for Inf - Inf, is returned as an error.
+func (e *Expression) EvaluateBig(scope map[string]*big.Float, prec uint) (result *big.Float, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+	return e.evaluateBig(scope, prec)
+}
+
+func (e *Expression) evaluateBig(scope map[string]*big.Float, prec uint) (*big.Float, error) {
+	switch e.Kind {
+	case ExprNumber:
+		if e.Name != "" {
+			if v, _, err := big.ParseFloat(e.Name, 10, prec, big.ToNearestEven); err == nil {
+				return v, nil
+			}
+		}
+		return new(big.Float).SetPrec(prec).SetFloat64(e.Value), nil
+	case ExprVariable:
+		if v, ok := scope[e.Name]; ok {
+			return new(big.Float).SetPrec(prec).Set(v), nil
+		}
+		switch e.Name {
+		case "pi":
+			return round(BigPi(prec+bigGuardBits), prec), nil
+		case "e":
+			v, err := BigExp(bigInt(1, prec+bigGuardBits))
+			if err != nil {
+				return nil, err
+			}
+			return round(v, prec), nil
+		}
+		return nil, fmt.Errorf("unknown variable %q", e.Name)
+	case ExprUnary:
+		a, err := e.Args[0].evaluateBig(scope, prec)
+		if err != nil {
+			return nil, err
+		}
+		return a.Neg(a), nil
+	case ExprBinary:
+		a, err := e.Args[0].evaluateBig(scope, prec)
+		if err != nil {
+			return nil, err
+		}
+		b, err := e.Args[1].evaluateBig(scope, prec)
+		if err != nil {
+			return nil, err
+		}
+		z := new(big.Float).SetPrec(prec)
+		switch e.Op {
+		case "+":
+			return z.Add(a, b), nil
+		case "-":
+			return z.Sub(a, b), nil
+		case "*":
+			return z.Mul(a, b), nil
+		case "/":
+			if b.Sign() == 0 {
+				return nil, fmt.Errorf("division by zero")
+			}
+			return z.Quo(a, b), nil
+		case "^":
+			return bigPow(a, b, prec)
+		}
+	case ExprCall:
+		args := make([]*big.Float, len(e.Args))
+		for i, arg := range e.Args {
+			v, err := arg.evaluateBig(scope, prec)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		if e.Name == "log" && len(args) == 2 {
+			return bigLogBase(args[0], args[1], prec)
+		}
+		return bigCall(e.Name, args[0], prec)
+	}
+	return nil, fmt.Errorf("invalid expression")
+}
+
+// bigCall evaluates one of exprFunctions at x with prec bits.
+func bigCall(name string, x *big.Float, prec uint) (*big.Float, error) {
+	w := prec + bigGuardBits
+	if x.IsInf() && name != "abs" && name != "atan" {
+		return nil, fmt.Errorf("%s is undefined at %s", name, bigShort(x))
+	}
+	x = new(big.Float).SetPrec(w).Set(x)
+	var v *big.Float
+	var err error
+	switch name {
+	case "sin":
+		v, err = BigSin(x)
+	case "cos":
+		v, err = BigCos(x)
+	case "tan", "sec", "csc", "cot":
+		num, err := BigSin(x)
+		if err != nil {
+			return nil, err
+		}
+		den, err := BigCos(x)
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case "sec":
+			num = bigInt(1, w)
+		case "csc":
+			num, den = bigInt(1, w), num
+		case "cot":
+			num, den = den, num
+		}
+		if den.Sign() == 0 {
+			return nil, fmt.Errorf("%s is undefined at %s", name, bigShort(x))
+		}
+		v = new(big.Float).SetPrec(w).Quo(num, den)
+	case "asin", "acos":
+		one := bigInt(1, w)
+		if new(big.Float).Abs(x).Cmp(one) > 0 {
+			return nil, fmt.Errorf("%s is undefined at %s", name, bigShort(x))
+		}
+		v = bigAsin(x)
+		if name == "acos" {
+			half := BigPi(w)
+			half.Quo(half, bigInt(2, w))
+			v = half.Sub(half, v)
+		}
+	case "atan":
+		v = BigAtan(x)
+	case "sinh", "cosh", "tanh":
+		if name == "tanh" && x.MantExp(nil) > 32 {
+			// e^|x| is out of range, and tanh x is +-1 to any precision.
+			v = bigInt(int64(x.Sign()), w)
+			break
+		}
+		ex, err := BigExp(x)
+		if err != nil {
+			return nil, err
+		}
+		inv := new(big.Float).SetPrec(w).Quo(bigInt(1, w), ex)
+		sum := new(big.Float).SetPrec(w).Add(ex, inv)
+		diff := new(big.Float).SetPrec(w).Sub(ex, inv)
+		switch name {
+		case "sinh":
+			v = diff.Quo(diff, bigInt(2, w))
+		case "cosh":
+			v = sum.Quo(sum, bigInt(2, w))
+		default:
+			v = diff.Quo(diff, sum)
+		}
+	case "exp":
+		v, err = BigExp(x)
+	case "log", "ln", "log10", "log2":
+		if x.Sign() <= 0 {
+			return nil, fmt.Errorf("%s is undefined at %s", name, bigShort(x))
+		}
+		v = BigLog(x)
+		switch name {
+		case "log10":
+			v.Quo(v, BigLog(bigInt(10, w)))
+		case "log2":
+			v.Quo(v, BigLog(bigInt(2, w)))
+		}
+	case "sqrt":
+		if x.Sign() < 0 {
+			return nil, fmt.Errorf("sqrt is undefined at %s", bigShort(x))
+		}
+		v = new(big.Float).SetPrec(w).Sqrt(x)
+	case "cbrt":
+		if x.Sign() == 0 {
+			return new(big.Float).SetPrec(prec), nil
+		}
+		abs := new(big.Float).Abs(x)
+		l := BigLog(abs)
+		if v, err = BigExp(l.Quo(l, bigInt(3, w))); err != nil {
+			return nil, err
+		}
+		if x.Sign() < 0 {
+			v.Neg(v)
+		}
+	case "abs":
+		v = new(big.Float).Abs(x)
+	default:
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return round(v, prec), nil
+}
+
+func bigLogBase(x, base *big.Float, prec uint) (*big.Float, error) {
+	w := prec + bigGuardBits
+	if x.Sign() <= 0 || base.Sign() <= 0 || base.Cmp(bigInt(1, w)) == 0 {
+		return nil, fmt.Errorf("log is undefined at %s with base %s", bigShort(x), bigShort(base))
+	}
+	v := BigLog(new(big.Float).SetPrec(w).Set(x))
+	v.Quo(v, BigLog(new(big.Float).SetPrec(w).Set(base)))
+	return round(v, prec), nil
+}
+
+// bigPow computes a^b exactly by repeated squaring for integer b, and as
+// exp(b log a) otherwise.
+func bigPow(a, b *big.Float, prec uint) (*big.Float, error) {
+	w := prec + bigGuardBits
+	if a.IsInf() || b.IsInf() {
+		return nil, fmt.Errorf("power %s^%s is undefined", bigShort(a), bigShort(b))
+	}
+	if b.IsInt() && new(big.Float).Abs(b).Cmp(bigInt(1<<20, w)) <= 0 {
+		n, _ := b.Int64()
+		if a.Sign() == 0 && n < 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		result := bigInt(1, w)
+		base := new(big.Float).SetPrec(w).Set(a)
+		for k := n; k != 0; k /= 2 {
+			if k%2 != 0 {
+				result.Mul(result, base)
+			}
+			base.Mul(base, base)
+		}
+		if n < 0 {
+			result.Quo(bigInt(1, w), result)
+		}
+		return round(result, prec), nil
+	}
+	if a.Sign() < 0 {
+		return nil, fmt.Errorf("power of a negative number to %s is undefined", bigShort(b))
+	}
+	if a.Sign() == 0 {
+		if b.Sign() <= 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return new(big.Float).SetPrec(prec), nil
+	}
+	l := BigLog(new(big.Float).SetPrec(w).Set(a))
+	v, err := BigExp(l.Mul(l, b))
+	if err != nil {
+		return nil, err
+	}
+	return round(v, prec), nil
+}
+
+// BigPi computes pi with Machin's formula 16 atan(1/5) - 4 atan(1/239).
+func BigPi(prec uint) *big.Float {
+	a := atanInverse(5, prec+8)
+	a.Mul(a, bigInt(16, prec+8))
+	b := atanInverse(239, prec+8)
+	b.Mul(b, bigInt(4, prec+8))
+	return round(a.Sub(a, b), prec)
+}
+
+// atanInverse sums the Taylor series of atan(1/n).
+func atanInverse(n int64, prec uint) *big.Float {
+	x := new(big.Float).SetPrec(prec).Quo(bigInt(1, prec), bigInt(n, prec))
+	return atanSeries(x)
+}
+
+// atanSeries sums x - x^3/3 + x^5/5 - ... for small |x|.
+func atanSeries(x *big.Float) *big.Float {
+	prec := x.Prec()
+	x2 := new(big.Float).SetPrec(prec).Mul(x, x)
+	power := new(big.Float).SetPrec(prec).Set(x)
+	sum := new(big.Float).SetPrec(prec).Set(x)
+	term := new(big.Float).SetPrec(prec)
+	for k := int64(1); ; k++ {
+		power.Mul(power, x2)
+		power.Neg(power)
+		term.Quo(power, bigInt(2*k+1, prec))
+		if negligible(term, sum) {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// BigExp computes e^x by scaling x down by 2^k, summing the Taylor series
+// and squaring the result k times. For |x| >= 2^32, e^x is beyond the
+// exponent range of big.Float: an error for x > 0 and 0 for x < 0.
+func BigExp(x *big.Float) (*big.Float, error) {
+	prec := x.Prec()
+	switch {
+	case x.IsInf():
+		return nil, fmt.Errorf("exp is undefined at %s", bigShort(x))
+	case x.Sign() == 0:
+		return bigInt(1, prec), nil
+	case x.MantExp(nil) > 32 && x.Sign() < 0:
+		return new(big.Float).SetPrec(prec), nil
+	case x.MantExp(nil) > 32:
+		return nil, fmt.Errorf("exp(%s) is too large", bigShort(x))
+	}
+	k := x.MantExp(nil) + 8
+	if k < 0 {
+		k = 0
+	}
+	w := prec + uint(k) + 16
+	r := new(big.Float).SetPrec(w).SetMantExp(new(big.Float).SetPrec(w).Set(x), -k)
+
+	sum := bigInt(1, w)
+	term := bigInt(1, w)
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, bigInt(n, w))
+		if negligible(term, sum) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+	return round(sum, prec), nil
+}
+
+// BigLog computes ln x for x > 0 from x = m 2^e with m in [0.5, 1), using
+// ln m = 2 atanh((m - 1) / (m + 1)) and ln 2 = 2 atanh(1/3).
+func BigLog(x *big.Float) *big.Float {
+	prec := x.Prec()
+	w := prec + 16
+	m := new(big.Float).SetPrec(w)
+	exp := x.MantExp(m)
+
+	one := bigInt(1, w)
+	z := new(big.Float).SetPrec(w).Sub(m, one)
+	z.Quo(z, new(big.Float).SetPrec(w).Add(m, one))
+	result := atanhSeries(z)
+	result.Mul(result, bigInt(2, w))
+
+	if exp != 0 {
+		ln2 := atanhSeries(new(big.Float).SetPrec(w).Quo(one, bigInt(3, w)))
+		ln2.Mul(ln2, bigInt(2*int64(exp), w))
+		result.Add(result, ln2)
+	}
+	return round(result, prec)
+}
+
+// atanhSeries sums z + z^3/3 + z^5/5 + ... for |z| <= 1/3.
+func atanhSeries(z *big.Float) *big.Float {
+	prec := z.Prec()
+	z2 := new(big.Float).SetPrec(prec).Mul(z, z)
+	power := new(big.Float).SetPrec(prec).Set(z)
+	sum := new(big.Float).SetPrec(prec).Set(z)
+	term := new(big.Float).SetPrec(prec)
+	if z.Sign() == 0 {
+		return sum
+	}
+	for k := int64(1); ; k++ {
+		power.Mul(power, z2)
+		term.Quo(power, bigInt(2*k+1, prec))
+		if negligible(term, sum) {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// BigSin computes sin x after reducing x to [-pi, pi].
+func BigSin(x *big.Float) (*big.Float, error) {
+	r, prec, err := reduceAngle(x)
+	if err != nil {
+		return nil, err
+	}
+	return round(sinCosSeries(r, 1), prec), nil
+}
+
+// BigCos computes cos x after reducing x to [-pi, pi].
+func BigCos(x *big.Float) (*big.Float, error) {
+	r, prec, err := reduceAngle(x)
+	if err != nil {
+		return nil, err
+	}
+	return round(sinCosSeries(r, 0), prec), nil
+}
+
+// reduceAngle returns x - 2 pi round(x / 2 pi) with enough extra bits to
+// cover the cancellation. It fails when x is infinite or so large that
+// the extra bits would exceed bigMaxPrec.
+func reduceAngle(x *big.Float) (*big.Float, uint, error) {
+	prec := x.Prec()
+	if x.IsInf() {
+		return nil, 0, fmt.Errorf("the angle %s is not finite", bigShort(x))
+	}
+	extra := x.MantExp(nil)
+	if extra < 0 {
+		extra = 0
+	}
+	if prec+uint(extra)+16 > bigMaxPrec {
+		return nil, 0, fmt.Errorf("the angle %s is too large to reduce", bigShort(x))
+	}
+	w := prec + uint(extra) + 16
+	twoPi := BigPi(w)
+	twoPi.Mul(twoPi, bigInt(2, w))
+	n := new(big.Float).SetPrec(w).Quo(x, twoPi)
+	n.Add(n, new(big.Float).SetPrec(w).SetFloat64(0.5*float64(n.Sign())))
+	whole, _ := n.Int(nil)
+	n.SetInt(whole)
+	r := new(big.Float).SetPrec(w).Set(x)
+	return r.Sub(r, n.Mul(n, twoPi)), prec, nil
+}
+
+// sinCosSeries sums the Taylor series of sin (start = 1) or cos (start = 0).
+func sinCosSeries(x *big.Float, start int64) *big.Float {
+	prec := x.Prec() + 8
+	x2 := new(big.Float).SetPrec(prec).Mul(x, x)
+	term := bigInt(1, prec)
+	if start == 1 {
+		term.Set(x)
+	}
+	sum := new(big.Float).SetPrec(prec).Set(term)
+	for n := start + 1; ; n += 2 {
+		term.Mul(term, x2)
+		term.Quo(term, bigInt(-n*(n+1), prec))
+		if negligible(term, sum) {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// BigAtan computes atan x using atan x = pi/2 - atan(1/x) for |x| > 1 and
+// the half-angle formula atan x = 2 atan(x / (1 + sqrt(1 + x^2))).
+func BigAtan(x *big.Float) *big.Float {
+	prec := x.Prec()
+	w := prec + 16
+	one := bigInt(1, w)
+	y := new(big.Float).SetPrec(w).Set(x)
+
+	if new(big.Float).Abs(y).Cmp(one) > 0 {
+		inv := new(big.Float).SetPrec(w).Quo(one, y)
+		half := BigPi(w)
+		half.Quo(half, bigInt(int64(2*y.Sign()), w))
+		return round(half.Sub(half, BigAtan(inv)), prec)
+	}
+
+	halvings := 0
+	eighth := new(big.Float).SetPrec(w).SetFloat64(0.125)
+	for new(big.Float).Abs(y).Cmp(eighth) > 0 {
+		root := new(big.Float).SetPrec(w).Mul(y, y)
+		root.Add(root, one)
+		root.Sqrt(root)
+		y.Quo(y, root.Add(root, one))
+		halvings++
+	}
+	result := atanSeries(y)
+	return round(result.SetMantExp(result, halvings), prec)
+}
+
+// bigAsin computes asin x = atan(x / sqrt(1 - x^2)) for |x| <= 1.
+func bigAsin(x *big.Float) *big.Float {
+	prec := x.Prec()
+	one := bigInt(1, prec)
+	root := new(big.Float).SetPrec(prec).Mul(x, x)
+	root.Sub(one, root)
+	if root.Sign() == 0 {
+		half := BigPi(prec)
+		return half.Quo(half, bigInt(int64(2*x.Sign()), prec))
+	}
+	root.Sqrt(root)
+	return BigAtan(root.Quo(x, root))
+}
+
+// negligible reports whether term no longer changes sum at its precision.
+func negligible(term, sum *big.Float) bool {
+	if term.Sign() == 0 {
+		return true
+	}
+	if sum.Sign() == 0 {
+		return false
+	}
+	return sum.MantExp(nil)-term.MantExp(nil) > int(sum.Prec())+2
+}
+
+func bigInt(v int64, prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetInt64(v)
+}
+
+func round(v *big.Float, prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).Set(v)
+}
+
+// BigFloat64 converts v to the nearest float64, saturating to +-MaxFloat64.
+func BigFloat64(v *big.Float) float64 {
+	f, _ := v.Float64()
+	if math.IsInf(f, 0) {
+		return math.Copysign(math.MaxFloat64, f)
+	}
+	return f
+}
+
+// bigShort formats x for an error message. Converting a number with a huge
+// exponent to decimal is slow, so such a number is shown as a power of two.
+func bigShort(x *big.Float) string {
+	if exp := x.MantExp(nil); !x.IsInf() && (exp > 1000 || exp < -1000) {
+		if x.Sign() < 0 {
+			return fmt.Sprintf("-2^%d", exp)
+		}
+		return fmt.Sprintf("2^%d", exp)
+	}
+	return x.Text('g', 10)
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEvaluateBig(t *testing.T) {
+	tests := []struct {
+		input string
+		x     float64
+		want  string
+	}{
+		{"0.1 + 0.2", 0, "0.3"},
+		{"sin(x)", 1, "0.8414709848"},
+		{"exp(x)", 1, "2.718281828"},
+		{"log(x)", 10, "2.302585093"},
+		{"x^10", 2, "1024"},
+		{"exp(-exp(x))", 1000, "0"},
+		{"tanh(x)", 1e10, "1"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Fatalf("ParseExpression(%q) error: %v", tt.input, err)
+		}
+		v, err := expr.EvaluateBig(map[string]*big.Float{"x": new(big.Float).SetPrec(128).SetFloat64(tt.x)}, 128)
+		if err != nil {
+			t.Errorf("%q at %v error: %v", tt.input, tt.x, err)
+			continue
+		}
+		if got := v.Text('g', 10); got != tt.want {
+			t.Errorf("%q at %v = %s, want %s", tt.input, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluateBigErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		x     float64
+		want  string
+	}{
+		{"sin(exp(exp(x)))", 1000, "too large"},
+		{"tanh(exp(exp(1000)))", 0, "too large"},
+		{"cos(x^1000000)", 1e300, "too large to reduce"},
+		{"log(x)", -1, "undefined"},
+		{"1/x", 0, "division by zero"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Fatalf("ParseExpression(%q) error: %v", tt.input, err)
+		}
+		_, err = expr.EvaluateBig(map[string]*big.Float{"x": new(big.Float).SetPrec(64).SetFloat64(tt.x)}, 64)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q at %v error = %v, want it to contain %q", tt.input, tt.x, err, tt.want)
+		}
+	}
+}
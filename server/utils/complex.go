@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// complexCall extends the elementary functions to complex arguments. cbrt and
+// abs follow the real branch used by Evaluate, so that f(x + ih) matches f on
+// the real axis for the complex-step method.
+func complexCall(name string, z complex128) (complex128, error) {
+	switch name {
+	case "sin":
+		return cmplx.Sin(z), nil
+	case "cos":
+		return cmplx.Cos(z), nil
+	case "tan":
+		return cmplx.Tan(z), nil
+	case "sec":
+		return 1 / cmplx.Cos(z), nil
+	case "csc":
+		return 1 / cmplx.Sin(z), nil
+	case "cot":
+		return cmplx.Cot(z), nil
+	case "asin":
+		return cmplx.Asin(z), nil
+	case "acos":
+		return cmplx.Acos(z), nil
+	case "atan":
+		return cmplx.Atan(z), nil
+	case "sinh":
+		return cmplx.Sinh(z), nil
+	case "cosh":
+		return cmplx.Cosh(z), nil
+	case "tanh":
+		return cmplx.Tanh(z), nil
+	case "exp":
+		return cmplx.Exp(z), nil
+	case "log", "ln":
+		return cmplx.Log(z), nil
+	case "log10":
+		return cmplx.Log10(z), nil
+	case "log2":
+		return cmplx.Log(z) / math.Ln2, nil
+	case "sqrt":
+		return cmplx.Sqrt(z), nil
+	case "cbrt":
+		if real(z) < 0 {
+			return -cmplx.Pow(-z, 1.0/3), nil
+		}
+		return cmplx.Pow(z, 1.0/3), nil
+	case "abs":
+		if real(z) < 0 {
+			return -z, nil
+		}
+		return z, nil
+	}
+	return 0, fmt.Errorf("unknown function %q", name)
+}
+
+// complexPow is z^w, by repeated squaring for small integer exponents so
+// that negative real bases keep a real result.
+func complexPow(z, w complex128) complex128 {
+	if imag(w) == 0 && real(w) == math.Trunc(real(w)) && math.Abs(real(w)) <= 64 {
+		n := int(real(w))
+		if n < 0 {
+			return 1 / complexPow(z, complex(float64(-n), 0))
+		}
+		result := complex(1, 0)
+		for ; n > 0; n >>= 1 {
+			if n&1 == 1 {
+				result *= z
+			}
+			z *= z
+		}
+		return result
+	}
+	return cmplx.Pow(z, w)
+}
+
+// EvaluateComplex computes the expression with variable set to the complex
+// value z.
+func (e *Expression) EvaluateComplex(variable string, z complex128) (complex128, error) {
+	switch e.Kind {
+	case ExprNumber:
+		return complex(e.Value, 0), nil
+	case ExprVariable:
+		if e.Name == variable {
+			return z, nil
+		}
+		if v, ok := exprConstants[e.Name]; ok {
+			return complex(v, 0), nil
+		}
+		return 0, fmt.Errorf("unknown variable %q", e.Name)
+	case ExprUnary:
+		a, err := e.Args[0].EvaluateComplex(variable, z)
+		return -a, err
+	case ExprBinary:
+		a, err := e.Args[0].EvaluateComplex(variable, z)
+		if err != nil {
+			return 0, err
+		}
+		b, err := e.Args[1].EvaluateComplex(variable, z)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case "+":
+			return a + b, nil
+		case "-":
+			return a - b, nil
+		case "*":
+			return a * b, nil
+		case "/":
+			return a / b, nil
+		case "^":
+			return complexPow(a, b), nil
+		}
+	case ExprCall:
+		a, err := e.Args[0].EvaluateComplex(variable, z)
+		if err != nil {
+			return 0, err
+		}
+		if e.Name == "log" && len(e.Args) == 2 {
+			base, err := e.Args[1].EvaluateComplex(variable, z)
+			if err != nil {
+				return 0, err
+			}
+			return cmplx.Log(a) / cmplx.Log(base), nil
+		}
+		return complexCall(e.Name, a)
+	}
+	return 0, fmt.Errorf("invalid expression")
+}
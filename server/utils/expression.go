@@ -0,0 +1,405 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ExprKind identifies the type of an Expression node.
+type ExprKind int
+
+const (
+	ExprNumber ExprKind = iota
+	ExprVariable
+	ExprUnary
+	ExprBinary
+	ExprCall
+)
+
+// Expression is a parsed equation string such as "x^3 - 2*sin(x) + 1".
+// The syntax follows the subset of mathjs used by the client pages:
+// + - * / ^, parentheses, implicit multiplication ("2x", "3(x+1)"),
+// the constants pi and e and the usual elementary functions. A name
+// followed by "(" is always a function call, so "x(x+1)" and "e(x)" are
+// errors rather than products. Number nodes
+// keep their literal text in Name so they can be re-read at higher precision.
+type Expression struct {
+	Kind  ExprKind
+	Op    string
+	Value float64
+	Name  string
+	Args  []*Expression
+}
+
+var exprConstants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+var exprFunctions = map[string]func(float64) float64{
+	"sin":   math.Sin,
+	"cos":   math.Cos,
+	"tan":   math.Tan,
+	"sec":   func(x float64) float64 { return 1 / math.Cos(x) },
+	"csc":   func(x float64) float64 { return 1 / math.Sin(x) },
+	"cot":   func(x float64) float64 { return 1 / math.Tan(x) },
+	"asin":  math.Asin,
+	"acos":  math.Acos,
+	"atan":  math.Atan,
+	"sinh":  math.Sinh,
+	"cosh":  math.Cosh,
+	"tanh":  math.Tanh,
+	"exp":   math.Exp,
+	"log":   math.Log,
+	"ln":    math.Log,
+	"log10": math.Log10,
+	"log2":  math.Log2,
+	"sqrt":  math.Sqrt,
+	"cbrt":  math.Cbrt,
+	"abs":   math.Abs,
+}
+
+// ParseExpression parses an equation string into an Expression tree.
+func ParseExpression(input string) (*Expression, error) {
+	tokens, err := tokenizeExpression(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	expr, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].text, p.tokens[p.pos].pos)
+	}
+	return expr, nil
+}
+
+// ParseFunction parses an equation string that may only use the given
+// variable and returns it as a function of that variable.
+func ParseFunction(input string, variable string) (func(float64) float64, error) {
+	expr, err := ParseExpression(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := expr.CheckVariables(variable); err != nil {
+		return nil, err
+	}
+	return expr.Func(variable), nil
+}
+
+// Evaluate computes the value of the expression with the given variable values.
+// Unknown variables evaluate to NaN.
+func (e *Expression) Evaluate(scope map[string]float64) float64 {
+	switch e.Kind {
+	case ExprNumber:
+		return e.Value
+	case ExprVariable:
+		if v, ok := scope[e.Name]; ok {
+			return v
+		}
+		if v, ok := exprConstants[e.Name]; ok {
+			return v
+		}
+		return math.NaN()
+	case ExprUnary:
+		return -e.Args[0].Evaluate(scope)
+	case ExprBinary:
+		a := e.Args[0].Evaluate(scope)
+		b := e.Args[1].Evaluate(scope)
+		switch e.Op {
+		case "+":
+			return a + b
+		case "-":
+			return a - b
+		case "*":
+			return a * b
+		case "/":
+			return a / b
+		case "^":
+			return math.Pow(a, b)
+		}
+	case ExprCall:
+		if e.Name == "log" && len(e.Args) == 2 {
+			return math.Log(e.Args[0].Evaluate(scope)) / math.Log(e.Args[1].Evaluate(scope))
+		}
+		return exprFunctions[e.Name](e.Args[0].Evaluate(scope))
+	}
+	return math.NaN()
+}
+
+// Func returns the expression as a function of a single variable.
+func (e *Expression) Func(variable string) func(float64) float64 {
+	return func(x float64) float64 {
+		return e.Evaluate(map[string]float64{variable: x})
+	}
+}
+
+// Variables returns the names of the free variables in the expression,
+// in order of first appearance.
+func (e *Expression) Variables() []string {
+	var names []string
+	seen := map[string]bool{}
+	var walk func(n *Expression)
+	walk = func(n *Expression) {
+		if n.Kind == ExprVariable && !seen[n.Name] {
+			if _, ok := exprConstants[n.Name]; !ok {
+				seen[n.Name] = true
+				names = append(names, n.Name)
+			}
+		}
+		for _, arg := range n.Args {
+			walk(arg)
+		}
+	}
+	walk(e)
+	return names
+}
+
+// CheckVariables returns an error if the expression uses a variable
+// outside of the allowed names.
+func (e *Expression) CheckVariables(allowed ...string) error {
+	for _, name := range e.Variables() {
+		found := false
+		for _, a := range allowed {
+			if name == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown variable %q", name)
+		}
+	}
+	return nil
+}
+
+type exprToken struct {
+	kind string // "num", "ident", "op", "(", ")", ","
+	text string
+	num  float64
+	pos  int
+}
+
+func tokenizeExpression(input string) ([]exprToken, error) {
+	var tokens []exprToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// Scientific notation, taking care not to swallow the constant e in "2e".
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			text := string(runes[start:i])
+			num, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, exprToken{kind: "num", text: text, num: num, pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: "ident", text: string(runes[start:i]), pos: start})
+		case strings.ContainsRune("+-*/^", r):
+			tokens = append(tokens, exprToken{kind: "op", text: string(r), pos: i})
+			i++
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, exprToken{kind: string(r), text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	return tokens, nil
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peek() *exprToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *exprParser) parseSum() (*Expression, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t == nil || t.kind != "op" || (t.text != "+" && t.text != "-") {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &Expression{Kind: ExprBinary, Op: t.text, Args: []*Expression{left, right}}
+	}
+}
+
+func (p *exprParser) parseProduct() (*Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		op := ""
+		switch {
+		case t == nil:
+			return left, nil
+		case t.kind == "op" && (t.text == "*" || t.text == "/"):
+			op = t.text
+			p.pos++
+		case t.kind == "num" || t.kind == "ident" || t.kind == "(":
+			// Implicit multiplication such as "2x" or "(x+1)(x-1)".
+			op = "*"
+		default:
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Expression{Kind: ExprBinary, Op: op, Args: []*Expression{left, right}}
+	}
+}
+
+func (p *exprParser) parseUnary() (*Expression, error) {
+	t := p.peek()
+	if t != nil && t.kind == "op" && (t.text == "-" || t.text == "+") {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if t.text == "+" {
+			return operand, nil
+		}
+		return &Expression{Kind: ExprUnary, Op: "-", Args: []*Expression{operand}}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *exprParser) parsePower() (*Expression, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if t != nil && t.kind == "op" && t.text == "^" {
+		p.pos++
+		// Right associative, and binds tighter than a leading minus: -x^2 = -(x^2).
+		exponent, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Expression{Kind: ExprBinary, Op: "^", Args: []*Expression{base, exponent}}, nil
+	}
+	return base, nil
+}
+
+func (p *exprParser) parsePrimary() (*Expression, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	switch t.kind {
+	case "num":
+		p.pos++
+		return &Expression{Kind: ExprNumber, Value: t.num, Name: t.text}, nil
+	case "ident":
+		p.pos++
+		if _, ok := exprFunctions[t.text]; ok {
+			next := p.peek()
+			if next == nil || next.kind != "(" {
+				return nil, fmt.Errorf("function %q at position %d requires parentheses", t.text, t.pos)
+			}
+			p.pos++
+			args, err := p.parseArguments()
+			if err != nil {
+				return nil, err
+			}
+			if len(args) != 1 && !(t.text == "log" && len(args) == 2) {
+				return nil, fmt.Errorf("function %q takes one argument", t.text)
+			}
+			return &Expression{Kind: ExprCall, Name: t.text, Args: args}, nil
+		}
+		// A name followed by "(" is a call, as in mathjs, so "e(x)" is an
+		// error rather than e*x.
+		if next := p.peek(); next != nil && next.kind == "(" {
+			if _, ok := exprConstants[t.text]; ok {
+				return nil, fmt.Errorf("%q at position %d is a constant, not a function", t.text, t.pos)
+			}
+			return nil, fmt.Errorf("unknown function %q at position %d", t.text, t.pos)
+		}
+		return &Expression{Kind: ExprVariable, Name: t.text}, nil
+	case "(":
+		p.pos++
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if next := p.peek(); next == nil || next.kind != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+func (p *exprParser) parseArguments() ([]*Expression, error) {
+	var args []*Expression
+	for {
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		t := p.peek()
+		if t == nil {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		if t.kind == ")" {
+			return args, nil
+		}
+		if t.kind != "," {
+			return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseExpressionEvaluate(t *testing.T) {
+	scope := map[string]float64{"x": 2, "y": 3}
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"1 + 2", 3},
+		{"7 - 2 - 1", 4},
+		{"8 / 4 / 2", 1},
+		{"2 + 3*4", 14},
+		{"(2 + 3)*4", 20},
+		{"2^3^2", 512},
+		{"-x^2", -4},
+		{"(-x)^2", 4},
+		{"2^-1", 0.5},
+		{"-2*-x", 4},
+		{"+x", 2},
+		{"x/y*3", 2},
+		{"1.5e2", 150},
+		{"2.5E-1", 0.25},
+		{".5", 0.5},
+		{"pi", math.Pi},
+		{"e", math.E},
+		{"2e", 2 * math.E},
+		{"2e+x", 2*math.E + 2},
+		{"sin(pi/2)", 1},
+		{"ln(e^2)", 2},
+		{"log(8, 2)", 3},
+		{"log10(1000)", 3},
+		{"sqrt(16) + cbrt(27)", 7},
+		{"abs(-x)", 2},
+		{"exp(0) + cos(0)", 2},
+		{"x^2 - 4*x + 4", 0},
+		{"x*y", 6},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Errorf("ParseExpression(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.Evaluate(scope); math.Abs(got-tt.want) > 1e-12*math.Max(1, math.Abs(tt.want)) {
+			t.Errorf("%q = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseExpressionImplicitMultiplication(t *testing.T) {
+	scope := map[string]float64{"x": 2, "y": 3}
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"2x", 4},
+		{"3(x+1)", 9},
+		{"(x+1)(x-1)", 3},
+		{"2pi", 2 * math.Pi},
+		{"x y", 6},
+		{"2x^2", 8},
+		{"-2x", -4},
+		{"2 sin(0) + 1", 1},
+		{"(x)2", 4},
+		{"1/2x", 1},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Errorf("ParseExpression(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.Evaluate(scope); math.Abs(got-tt.want) > 1e-12*math.Max(1, math.Abs(tt.want)) {
+			t.Errorf("%q = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", "empty expression"},
+		{"   ", "empty expression"},
+		{"x +", "unexpected end of expression"},
+		{"(x + 1", "missing closing parenthesis"},
+		{"x + 1)", `unexpected ")"`},
+		{"2 ** 3", `unexpected "*"`},
+		{"x # 2", "unexpected character"},
+		{"sin x", "requires parentheses"},
+		{"sin(1, 2)", "takes one argument"},
+		{"e(x)", "is a constant, not a function"},
+		{"pi(2)", "is a constant, not a function"},
+		{"foo(x)", `unknown function "foo"`},
+		{"x(x+1)", `unknown function "x"`},
+		{"log(8,", "unexpected end of expression"},
+	}
+	for _, tt := range tests {
+		_, err := ParseExpression(tt.input)
+		if err == nil {
+			t.Errorf("ParseExpression(%q) succeeded, want error containing %q", tt.input, tt.want)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseExpression(%q) error %q, want it to contain %q", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestCheckVariables(t *testing.T) {
+	tests := []struct {
+		input   string
+		allowed []string
+		wantErr bool
+	}{
+		{"x^2 + pi*e", []string{"x"}, false},
+		{"x*y", []string{"x", "y"}, false},
+		{"x*y", []string{"x"}, true},
+		{"2 + 3", nil, false},
+		{"t", []string{"x"}, true},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Fatalf("ParseExpression(%q) error: %v", tt.input, err)
+		}
+		if err := expr.CheckVariables(tt.allowed...); (err != nil) != tt.wantErr {
+			t.Errorf("CheckVariables(%q, %v) error = %v, want error %v", tt.input, tt.allowed, err, tt.wantErr)
+		}
+	}
+}
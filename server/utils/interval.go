@@ -0,0 +1,403 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interval is a closed set of reals [Lo, Hi]. Endpoints are rounded outward
+// after every operation, so the true value is always inside the result.
+type Interval struct {
+	Lo float64
+	Hi float64
+}
+
+// entireInterval is returned wherever no useful bound is known.
+var entireInterval = Interval{math.Inf(-1), math.Inf(1)}
+
+// machineEpsilon is the spacing of float64 values around 1.
+const machineEpsilon = 0x1p-52
+
+// Point returns the interval [x, x].
+func Point(x float64) Interval {
+	return Interval{x, x}
+}
+
+// Width returns Hi - Lo.
+func (a Interval) Width() float64 {
+	return a.Hi - a.Lo
+}
+
+// Mid returns the midpoint of a bounded interval.
+func (a Interval) Mid() float64 {
+	return a.Lo + (a.Hi-a.Lo)/2
+}
+
+// Contains reports whether x lies in the interval.
+func (a Interval) Contains(x float64) bool {
+	return a.Lo <= x && x <= a.Hi
+}
+
+// Intersect returns the common part of a and b, or false when it is empty.
+func (a Interval) Intersect(b Interval) (Interval, bool) {
+	r := Interval{math.Max(a.Lo, b.Lo), math.Min(a.Hi, b.Hi)}
+	return r, r.Lo <= r.Hi
+}
+
+// Add returns a + b.
+func (a Interval) Add(b Interval) Interval {
+	return outward(a.Lo+b.Lo, a.Hi+b.Hi, 1)
+}
+
+// Sub returns a - b.
+func (a Interval) Sub(b Interval) Interval {
+	return outward(a.Lo-b.Hi, a.Hi-b.Lo, 1)
+}
+
+// Mul returns a * b.
+func (a Interval) Mul(b Interval) Interval {
+	products := []float64{
+		product(a.Lo, b.Lo), product(a.Lo, b.Hi),
+		product(a.Hi, b.Lo), product(a.Hi, b.Hi),
+	}
+	lo, hi := products[0], products[0]
+	for _, p := range products[1:] {
+		lo, hi = math.Min(lo, p), math.Max(hi, p)
+	}
+	return outward(lo, hi, 1)
+}
+
+// Div returns a / b, or the entire real line when b contains zero.
+func (a Interval) Div(b Interval) Interval {
+	if b.Contains(0) {
+		return entireInterval
+	}
+	return a.Mul(outward(1/b.Hi, 1/b.Lo, 1))
+}
+
+func (a Interval) neg() Interval {
+	return Interval{-a.Hi, -a.Lo}
+}
+
+// product treats 0 * Inf as 0, as the endpoints stand for bounded reals.
+func product(x, y float64) float64 {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return x * y
+}
+
+// outward widens [lo, hi] by the given number of ulps on each side.
+func outward(lo, hi float64, ulps int) Interval {
+	if math.IsNaN(lo) || math.IsNaN(hi) {
+		return entireInterval
+	}
+	for i := 0; i < ulps; i++ {
+		lo = math.Nextafter(lo, math.Inf(-1))
+		hi = math.Nextafter(hi, math.Inf(1))
+	}
+	return Interval{lo, hi}
+}
+
+// monotone encloses f over a for an increasing (or decreasing) f computed
+// by the math package, which is accurate to about one ulp.
+func monotone(f func(float64) float64, a Interval, increasing bool) Interval {
+	lo, hi := f(a.Lo), f(a.Hi)
+	if !increasing {
+		lo, hi = hi, lo
+	}
+	return outward(lo, hi, 2)
+}
+
+// intervalSin encloses sin over a, including the extrema at pi/2 + k pi
+// that fall inside a.
+func intervalSin(a Interval) Interval {
+	if math.IsInf(a.Lo, 0) || math.IsInf(a.Hi, 0) || a.Width() >= 2*math.Pi {
+		return Interval{-1, 1}
+	}
+	s1, s2 := math.Sin(a.Lo), math.Sin(a.Hi)
+	r := outward(math.Min(s1, s2), math.Max(s1, s2), 2)
+	slack := 4 * math.Nextafter(math.Max(math.Abs(a.Lo), math.Max(math.Abs(a.Hi), 1)), math.Inf(1)) * machineEpsilon
+	contains := func(offset float64) bool {
+		k := math.Ceil((a.Lo - offset - slack) / (2 * math.Pi))
+		return offset+2*math.Pi*k <= a.Hi+slack
+	}
+	if contains(math.Pi / 2) {
+		r.Hi = 1
+	}
+	if contains(-math.Pi / 2) {
+		r.Lo = -1
+	}
+	return Interval{math.Max(r.Lo, -1), math.Min(r.Hi, 1)}
+}
+
+// intervalPow encloses a^b, exactly following the sign rules for integer b.
+func intervalPow(a, b Interval) (Interval, error) {
+	if b.Lo == b.Hi && b.Lo == math.Trunc(b.Lo) && math.Abs(b.Lo) <= 1<<20 {
+		n := int(b.Lo)
+		if n == 0 {
+			return Point(1), nil
+		}
+		if n < 0 {
+			p, err := intervalPow(a, Point(float64(-n)))
+			if err != nil {
+				return Interval{}, err
+			}
+			return Point(1).Div(p), nil
+		}
+		lo, hi := math.Pow(a.Lo, float64(n)), math.Pow(a.Hi, float64(n))
+		if n%2 == 1 {
+			return outward(lo, hi, 2), nil
+		}
+		if a.Contains(0) {
+			return outward(0, math.Max(lo, hi), 2).clip(0, math.Inf(1)), nil
+		}
+		return outward(math.Min(lo, hi), math.Max(lo, hi), 2), nil
+	}
+	if a.Hi < 0 {
+		return Interval{}, fmt.Errorf("power of a negative number is undefined")
+	}
+	a.Lo = math.Max(a.Lo, 0)
+	return intervalCall("exp", b.Mul(monotone(math.Log, a, true)))
+}
+
+func (a Interval) clip(lo, hi float64) Interval {
+	return Interval{math.Max(a.Lo, lo), math.Min(a.Hi, hi)}
+}
+
+// intervalCall encloses one of exprFunctions over a. Parts of a outside the
+// domain are ignored; an error means the function is undefined on all of a.
+func intervalCall(name string, a Interval) (Interval, error) {
+	undefined := fmt.Errorf("%s is undefined on [%g, %g]", name, a.Lo, a.Hi)
+	switch name {
+	case "sin":
+		return intervalSin(a), nil
+	case "cos":
+		return intervalSin(a.Add(outward(math.Pi/2, math.Pi/2, 1))), nil
+	case "tan":
+		k := math.Ceil((a.Lo - math.Pi/2) / math.Pi)
+		if math.IsInf(a.Width(), 0) || math.Pi/2+k*math.Pi <= a.Hi+4*machineEpsilon*math.Max(math.Abs(a.Hi), 1) {
+			return entireInterval, nil
+		}
+		return monotone(math.Tan, a, true), nil
+	case "sec", "csc", "cot":
+		s, _ := intervalCall("sin", a)
+		c, _ := intervalCall("cos", a)
+		switch name {
+		case "sec":
+			return Point(1).Div(c), nil
+		case "csc":
+			return Point(1).Div(s), nil
+		default:
+			return c.Div(s), nil
+		}
+	case "asin", "acos":
+		d, ok := a.Intersect(Interval{-1, 1})
+		if !ok {
+			return Interval{}, undefined
+		}
+		if name == "asin" {
+			return monotone(math.Asin, d, true), nil
+		}
+		return monotone(math.Acos, d, false), nil
+	case "atan":
+		return monotone(math.Atan, a, true), nil
+	case "sinh":
+		return monotone(math.Sinh, a, true), nil
+	case "cosh":
+		if a.Contains(0) {
+			return outward(1, math.Max(math.Cosh(a.Lo), math.Cosh(a.Hi)), 2).clip(1, math.Inf(1)), nil
+		}
+		return monotone(math.Cosh, a, a.Lo > 0), nil
+	case "tanh":
+		return monotone(math.Tanh, a, true).clip(-1, 1), nil
+	case "exp":
+		return monotone(math.Exp, a, true).clip(0, math.Inf(1)), nil
+	case "log", "ln", "log10", "log2":
+		if a.Hi <= 0 {
+			return Interval{}, undefined
+		}
+		f := map[string]func(float64) float64{"log": math.Log, "ln": math.Log, "log10": math.Log10, "log2": math.Log2}[name]
+		return monotone(f, Interval{math.Max(a.Lo, 0), a.Hi}, true), nil
+	case "sqrt":
+		if a.Hi < 0 {
+			return Interval{}, undefined
+		}
+		return monotone(math.Sqrt, Interval{math.Max(a.Lo, 0), a.Hi}, true).clip(0, math.Inf(1)), nil
+	case "cbrt":
+		return monotone(math.Cbrt, a, true), nil
+	case "abs":
+		if a.Contains(0) {
+			return Interval{0, math.Max(-a.Lo, a.Hi)}, nil
+		}
+		if a.Hi < 0 {
+			return a.neg(), nil
+		}
+		return a, nil
+	}
+	return Interval{}, fmt.Errorf("unknown function %q", name)
+}
+
+// intervalDerivative encloses f'(a) for one of exprFunctions.
+func intervalDerivative(name string, a, value Interval) (Interval, error) {
+	one := Point(1)
+	switch name {
+	case "sin":
+		return intervalCall("cos", a)
+	case "cos":
+		s, err := intervalCall("sin", a)
+		return s.neg(), err
+	case "tan":
+		return one.Add(value.Mul(value)), nil
+	case "sec":
+		t, err := intervalCall("tan", a)
+		return value.Mul(t), err
+	case "csc":
+		c, err := intervalCall("cot", a)
+		return value.Mul(c).neg(), err
+	case "cot":
+		return one.Add(value.Mul(value)).neg(), nil
+	case "asin", "acos":
+		r, err := intervalCall("sqrt", one.Sub(a.Mul(a)))
+		if err != nil {
+			return Interval{}, err
+		}
+		d := one.Div(r)
+		if name == "acos" {
+			d = d.neg()
+		}
+		return d, nil
+	case "atan":
+		return one.Div(one.Add(a.Mul(a))), nil
+	case "sinh":
+		return intervalCall("cosh", a)
+	case "cosh":
+		return intervalCall("sinh", a)
+	case "tanh":
+		return one.Sub(value.Mul(value)), nil
+	case "exp":
+		return value, nil
+	case "log", "ln":
+		return one.Div(a), nil
+	case "log10":
+		return one.Div(a.Mul(outward(math.Ln10, math.Ln10, 1))), nil
+	case "log2":
+		return one.Div(a.Mul(outward(math.Ln2, math.Ln2, 1))), nil
+	case "sqrt":
+		return one.Div(value.Mul(Point(2))), nil
+	case "cbrt":
+		return one.Div(value.Mul(value).Mul(Point(3))), nil
+	case "abs":
+		switch {
+		case a.Lo > 0:
+			return one, nil
+		case a.Hi < 0:
+			return one.neg(), nil
+		}
+		return Interval{-1, 1}, nil
+	}
+	return Interval{}, fmt.Errorf("unknown function %q", name)
+}
+
+// EvaluateInterval encloses the range of the expression and of its
+// derivative with respect to variable over x, using interval arithmetic
+// with forward-mode differentiation.
+func (e *Expression) EvaluateInterval(variable string, x Interval) (Interval, Interval, error) {
+	switch e.Kind {
+	case ExprNumber:
+		if e.Value == math.Trunc(e.Value) && math.Abs(e.Value) < 1<<53 {
+			return Point(e.Value), Point(0), nil
+		}
+		// Decimal literals such as 0.1 are not exact in float64.
+		return outward(e.Value, e.Value, 1), Point(0), nil
+	case ExprVariable:
+		if e.Name == variable {
+			return x, Point(1), nil
+		}
+		if v, ok := exprConstants[e.Name]; ok {
+			return outward(v, v, 1), Point(0), nil
+		}
+		return Interval{}, Interval{}, fmt.Errorf("unknown variable %q", e.Name)
+	case ExprUnary:
+		v, d, err := e.Args[0].EvaluateInterval(variable, x)
+		return v.neg(), d.neg(), err
+	case ExprBinary:
+		a, da, err := e.Args[0].EvaluateInterval(variable, x)
+		if err != nil {
+			return Interval{}, Interval{}, err
+		}
+		b, db, err := e.Args[1].EvaluateInterval(variable, x)
+		if err != nil {
+			return Interval{}, Interval{}, err
+		}
+		switch e.Op {
+		case "+":
+			return a.Add(b), da.Add(db), nil
+		case "-":
+			return a.Sub(b), da.Sub(db), nil
+		case "*":
+			return a.Mul(b), da.Mul(b).Add(a.Mul(db)), nil
+		case "/":
+			if b.Lo == 0 && b.Hi == 0 {
+				return Interval{}, Interval{}, fmt.Errorf("division by zero")
+			}
+			v := a.Div(b)
+			return v, da.Sub(v.Mul(db)).Div(b), nil
+		case "^":
+			v, err := intervalPow(a, b)
+			if err != nil {
+				return Interval{}, Interval{}, err
+			}
+			if db.Lo == 0 && db.Hi == 0 {
+				// d(a^c) = c a^(c-1) da
+				exponent := b.Sub(Point(1))
+				if b.Lo == b.Hi && b.Lo == math.Trunc(b.Lo) {
+					exponent = Point(b.Lo - 1)
+				}
+				p, err := intervalPow(a, exponent)
+				if err != nil {
+					return Interval{}, Interval{}, err
+				}
+				return v, b.Mul(p).Mul(da), nil
+			}
+			// d(a^b) = a^b (db log a + b da / a)
+			l, err := intervalCall("log", a)
+			if err != nil {
+				return Interval{}, Interval{}, err
+			}
+			return v, v.Mul(db.Mul(l).Add(b.Mul(da).Div(a))), nil
+		}
+	case ExprCall:
+		a, da, err := e.Args[0].EvaluateInterval(variable, x)
+		if err != nil {
+			return Interval{}, Interval{}, err
+		}
+		if e.Name == "log" && len(e.Args) == 2 {
+			base, dbase, err := e.Args[1].EvaluateInterval(variable, x)
+			if err != nil {
+				return Interval{}, Interval{}, err
+			}
+			la, err := intervalCall("log", a)
+			if err != nil {
+				return Interval{}, Interval{}, err
+			}
+			lb, err := intervalCall("log", base)
+			if err != nil {
+				return Interval{}, Interval{}, err
+			}
+			v := la.Div(lb)
+			d := da.Div(a).Sub(v.Mul(dbase.Div(base))).Div(lb)
+			return v, d, nil
+		}
+		v, err := intervalCall(e.Name, a)
+		if err != nil {
+			return Interval{}, Interval{}, err
+		}
+		d, err := intervalDerivative(e.Name, a, v)
+		if err != nil {
+			return Interval{}, Interval{}, err
+		}
+		return v, d.Mul(da), nil
+	}
+	return Interval{}, Interval{}, fmt.Errorf("invalid expression")
+}
@@ -0,0 +1,289 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+)
+
+// Jet is a truncated Taylor series a[0] + a[1] t + ... + a[n] t^n, so
+// a[k] = f^(k)(x) / k!. Jets of order 1 are dual numbers; jets of order 2
+// hold the same values as hyper-dual numbers seeded with e1 = e2 = 1, where
+// the e1e2 part is a[2] * 2. Higher orders extend the same forward-mode
+// arithmetic, so every derivative is exact up to rounding.
+type Jet []float64
+
+func constantJet(v float64, order int) Jet {
+	a := make(Jet, order+1)
+	a[0] = v
+	return a
+}
+
+func (a Jet) isConstant() bool {
+	for _, v := range a[1:] {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Jet) add(b Jet, sign float64) Jet {
+	c := make(Jet, len(a))
+	for k := range a {
+		c[k] = a[k] + sign*b[k]
+	}
+	return c
+}
+
+func (a Jet) scale(s float64) Jet {
+	c := make(Jet, len(a))
+	for k := range a {
+		c[k] = s * a[k]
+	}
+	return c
+}
+
+func (a Jet) mul(b Jet) Jet {
+	c := make(Jet, len(a))
+	for k := range a {
+		for j := 0; j <= k; j++ {
+			c[k] += a[j] * b[k-j]
+		}
+	}
+	return c
+}
+
+func (a Jet) div(b Jet) Jet {
+	c := make(Jet, len(a))
+	for k := range a {
+		sum := a[k]
+		for j := 1; j <= k; j++ {
+			sum -= b[j] * c[k-j]
+		}
+		c[k] = sum / b[0]
+	}
+	return c
+}
+
+// compose returns g(a) given g(a[0]) and the jet of g'(a), using
+// d/dt g(a(t)) = g'(a(t)) a'(t).
+func (a Jet) compose(value float64, derivative Jet) Jet {
+	c := make(Jet, len(a))
+	c[0] = value
+	for k := 1; k < len(a); k++ {
+		for j := 1; j <= k; j++ {
+			c[k] += float64(j) * a[j] * derivative[k-j]
+		}
+		c[k] /= float64(k)
+	}
+	return c
+}
+
+func (a Jet) exp() Jet {
+	c := make(Jet, len(a))
+	c[0] = math.Exp(a[0])
+	for k := 1; k < len(a); k++ {
+		for j := 1; j <= k; j++ {
+			c[k] += float64(j) * a[j] * c[k-j]
+		}
+		c[k] /= float64(k)
+	}
+	return c
+}
+
+func (a Jet) log() Jet {
+	c := make(Jet, len(a))
+	c[0] = math.Log(a[0])
+	for k := 1; k < len(a); k++ {
+		sum := a[k]
+		for j := 1; j < k; j++ {
+			sum -= float64(j) * c[j] * a[k-j] / float64(k)
+		}
+		c[k] = sum / a[0]
+	}
+	return c
+}
+
+// sinCos returns sin(a) and cos(a), or sinh(a) and cosh(a) when hyperbolic.
+func (a Jet) sinCos(hyperbolic bool) (Jet, Jet) {
+	s, c := make(Jet, len(a)), make(Jet, len(a))
+	sign := -1.0
+	if hyperbolic {
+		s[0], c[0], sign = math.Sinh(a[0]), math.Cosh(a[0]), 1
+	} else {
+		s[0], c[0] = math.Sin(a[0]), math.Cos(a[0])
+	}
+	for k := 1; k < len(a); k++ {
+		for j := 1; j <= k; j++ {
+			s[k] += float64(j) * a[j] * c[k-j]
+			c[k] += float64(j) * a[j] * s[k-j]
+		}
+		s[k] /= float64(k)
+		c[k] *= sign / float64(k)
+	}
+	return s, c
+}
+
+// powInt is a^n by repeated squaring, which stays exact where a[0] = 0.
+func (a Jet) powInt(n int) Jet {
+	if n < 0 {
+		return constantJet(1, len(a)-1).div(a.powInt(-n))
+	}
+	result, base := constantJet(1, len(a)-1), a
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.mul(base)
+		}
+		base = base.mul(base)
+	}
+	return result
+}
+
+// powReal is a^r for a constant exponent r. Integer exponents go through
+// powInt, since the recurrence below divides by a[0] and fails at zero.
+func (a Jet) powReal(r float64) Jet {
+	if r == math.Trunc(r) && math.Abs(r) <= 1<<62 {
+		return a.powInt(int(r))
+	}
+	c := make(Jet, len(a))
+	c[0] = math.Pow(a[0], r)
+	for k := 1; k < len(a); k++ {
+		for j := 1; j <= k; j++ {
+			c[k] += ((r+1)*float64(j) - float64(k)) * a[j] * c[k-j]
+		}
+		c[k] /= float64(k) * a[0]
+	}
+	return c
+}
+
+func (a Jet) pow(b Jet) Jet {
+	if b.isConstant() {
+		return a.powReal(b[0])
+	}
+	return b.mul(a.log()).exp()
+}
+
+func jetCall(name string, a Jet) (Jet, error) {
+	one := constantJet(1, len(a)-1)
+	switch name {
+	case "sin":
+		s, _ := a.sinCos(false)
+		return s, nil
+	case "cos":
+		_, c := a.sinCos(false)
+		return c, nil
+	case "tan":
+		s, c := a.sinCos(false)
+		return s.div(c), nil
+	case "sec":
+		_, c := a.sinCos(false)
+		return one.div(c), nil
+	case "csc":
+		s, _ := a.sinCos(false)
+		return one.div(s), nil
+	case "cot":
+		s, c := a.sinCos(false)
+		return c.div(s), nil
+	case "asin":
+		return a.compose(math.Asin(a[0]), one.add(a.mul(a), -1).powReal(-0.5)), nil
+	case "acos":
+		return a.compose(math.Acos(a[0]), one.add(a.mul(a), -1).powReal(-0.5).scale(-1)), nil
+	case "atan":
+		return a.compose(math.Atan(a[0]), one.div(one.add(a.mul(a), 1))), nil
+	case "sinh":
+		s, _ := a.sinCos(true)
+		return s, nil
+	case "cosh":
+		_, c := a.sinCos(true)
+		return c, nil
+	case "tanh":
+		s, c := a.sinCos(true)
+		return s.div(c), nil
+	case "exp":
+		return a.exp(), nil
+	case "log", "ln":
+		return a.log(), nil
+	case "log10":
+		return a.log().scale(1 / math.Ln10), nil
+	case "log2":
+		return a.log().scale(1 / math.Ln2), nil
+	case "sqrt":
+		return a.powReal(0.5), nil
+	case "cbrt":
+		if a[0] < 0 {
+			return a.scale(-1).powReal(1.0 / 3).scale(-1), nil
+		}
+		return a.powReal(1.0 / 3), nil
+	case "abs":
+		if a[0] == 0 && len(a) > 1 {
+			return nil, fmt.Errorf("abs is not differentiable at 0")
+		}
+		if a[0] < 0 {
+			return a.scale(-1), nil
+		}
+		return a, nil
+	}
+	return nil, fmt.Errorf("unknown function %q", name)
+}
+
+// EvaluateJet returns the Taylor coefficients of the expression in variable
+// about x up to the given order. Multiply a[k] by k! for f^(k)(x).
+func (e *Expression) EvaluateJet(variable string, x float64, order int) (Jet, error) {
+	switch e.Kind {
+	case ExprNumber:
+		return constantJet(e.Value, order), nil
+	case ExprVariable:
+		if e.Name == variable {
+			a := constantJet(x, order)
+			if order > 0 {
+				a[1] = 1
+			}
+			return a, nil
+		}
+		if v, ok := exprConstants[e.Name]; ok {
+			return constantJet(v, order), nil
+		}
+		return nil, fmt.Errorf("unknown variable %q", e.Name)
+	case ExprUnary:
+		a, err := e.Args[0].EvaluateJet(variable, x, order)
+		if err != nil {
+			return nil, err
+		}
+		return a.scale(-1), nil
+	case ExprBinary:
+		a, err := e.Args[0].EvaluateJet(variable, x, order)
+		if err != nil {
+			return nil, err
+		}
+		b, err := e.Args[1].EvaluateJet(variable, x, order)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Op {
+		case "+":
+			return a.add(b, 1), nil
+		case "-":
+			return a.add(b, -1), nil
+		case "*":
+			return a.mul(b), nil
+		case "/":
+			return a.div(b), nil
+		case "^":
+			return a.pow(b), nil
+		}
+	case ExprCall:
+		a, err := e.Args[0].EvaluateJet(variable, x, order)
+		if err != nil {
+			return nil, err
+		}
+		if e.Name == "log" && len(e.Args) == 2 {
+			base, err := e.Args[1].EvaluateJet(variable, x, order)
+			if err != nil {
+				return nil, err
+			}
+			return a.log().div(base.log()), nil
+		}
+		return jetCall(e.Name, a)
+	}
+	return nil, fmt.Errorf("invalid expression")
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ParseFloatList parses a comma separated list of numbers such as the
+// matrix_data and constant_data fields.
+func ParseFloatList(input string) ([]float64, error) {
+	fields := strings.Split(input, ",")
+	values := make([]float64, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// ParseBigFloatList parses the same lists as ParseFloatList into big.Floats
+// with prec bits, reading each number from its decimal text.
+func ParseBigFloatList(input string, prec uint) ([]*big.Float, error) {
+	fields := strings.Split(input, ",")
+	values := make([]*big.Float, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		value, _, err := big.ParseFloat(field, 10, prec, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
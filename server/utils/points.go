@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePointFields parses the points format used by the interpolation and
+// regression pages, "x:1 fx:2, x:3 fx:4", into one map per point.
+func ParsePointFields(input string) ([]map[string]float64, error) {
+	var points []map[string]float64
+	for i, entry := range strings.Split(input, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		point := map[string]float64{}
+		for _, field := range strings.Fields(entry) {
+			key, text, found := strings.Cut(field, ":")
+			if !found {
+				return nil, fmt.Errorf("point %d: expected key:value, got %q", i+1, field)
+			}
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("point %d: invalid number %q", i+1, text)
+			}
+			point[key] = value
+		}
+		points = append(points, point)
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no points given")
+	}
+	return points, nil
+}
+
+// ParsePoints parses "x:1 fx:2, x:3 fx:4" into the x and f(x) columns.
+func ParsePoints(input string) ([]float64, []float64, error) {
+	points, err := ParsePointFields(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	for i, point := range points {
+		x, hasX := point["x"]
+		fx, hasFx := point["fx"]
+		if !hasX || !hasFx {
+			return nil, nil, fmt.Errorf("point %d must have x and fx", i+1)
+		}
+		xs[i], ys[i] = x, fx
+	}
+	return xs, ys, nil
+}
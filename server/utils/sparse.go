@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SparseEntry is one non-zero of a sparse matrix, with 0-based indices.
+type SparseEntry struct {
+	Row   int
+	Col   int
+	Value float64
+}
+
+// ParseSparseEntries parses COO triplets written as "row col value" with
+// 1-based indices and separated by commas, e.g. "1 1 4, 1 2 -1, 2 2 4".
+func ParseSparseEntries(input string, size int) ([]SparseEntry, error) {
+	var entries []SparseEntry
+	for i, triplet := range strings.Split(input, ",") {
+		fields := strings.Fields(triplet)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("entry %d: expected \"row col value\", got %q", i+1, strings.TrimSpace(triplet))
+		}
+		entry, err := parseSparseEntry(fields, size)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no matrix entries given")
+	}
+	return entries, nil
+}
+
+// FormatSparseEntries writes entries in the format read by ParseSparseEntries.
+func FormatSparseEntries(entries []SparseEntry) string {
+	var sb strings.Builder
+	for i, entry := range entries {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.Itoa(entry.Row + 1))
+		sb.WriteByte(' ')
+		sb.WriteString(strconv.Itoa(entry.Col + 1))
+		sb.WriteByte(' ')
+		sb.WriteString(strconv.FormatFloat(entry.Value, 'g', -1, 64))
+	}
+	return sb.String()
+}
+
+// ParseMatrixMarket reads a square matrix in Matrix Market coordinate format
+// (real, integer or pattern; general, symmetric or skew-symmetric) and
+// returns its size and entries.
+func ParseMatrixMarket(r io.Reader) (int, []SparseEntry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+
+	if !scanner.Scan() {
+		return 0, nil, fmt.Errorf("empty Matrix Market file")
+	}
+	header := strings.Fields(strings.ToLower(scanner.Text()))
+	if len(header) != 5 || header[0] != "%%matrixmarket" || header[1] != "matrix" {
+		return 0, nil, fmt.Errorf("missing %%%%MatrixMarket matrix header")
+	}
+	if header[2] != "coordinate" {
+		return 0, nil, fmt.Errorf("only coordinate Matrix Market files are supported")
+	}
+	field, symmetry := header[3], header[4]
+	if field != "real" && field != "integer" && field != "pattern" {
+		return 0, nil, fmt.Errorf("unsupported Matrix Market field %q", field)
+	}
+	if symmetry != "general" && symmetry != "symmetric" && symmetry != "skew-symmetric" {
+		return 0, nil, fmt.Errorf("unsupported Matrix Market symmetry %q", symmetry)
+	}
+
+	size, expected := 0, -1
+	var entries []SparseEntry
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if expected < 0 {
+			if len(fields) != 3 {
+				return 0, nil, fmt.Errorf("invalid size line %q", line)
+			}
+			rows, err1 := strconv.Atoi(fields[0])
+			cols, err2 := strconv.Atoi(fields[1])
+			nnz, err3 := strconv.Atoi(fields[2])
+			if err1 != nil || err2 != nil || err3 != nil || rows <= 0 || nnz < 0 {
+				return 0, nil, fmt.Errorf("invalid size line %q", line)
+			}
+			if rows != cols {
+				return 0, nil, fmt.Errorf("matrix must be square, got %dx%d", rows, cols)
+			}
+			size, expected = rows, nnz
+			continue
+		}
+		if field == "pattern" {
+			fields = append(fields, "1")
+		}
+		if len(fields) != 3 {
+			return 0, nil, fmt.Errorf("invalid entry line %q", line)
+		}
+		entry, err := parseSparseEntry(fields, size)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid entry line %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+		if entry.Row != entry.Col {
+			switch symmetry {
+			case "symmetric":
+				entries = append(entries, SparseEntry{Row: entry.Col, Col: entry.Row, Value: entry.Value})
+			case "skew-symmetric":
+				entries = append(entries, SparseEntry{Row: entry.Col, Col: entry.Row, Value: -entry.Value})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, err
+	}
+	if expected < 0 {
+		return 0, nil, fmt.Errorf("missing size line")
+	}
+	return size, entries, nil
+}
+
+func parseSparseEntry(fields []string, size int) (SparseEntry, error) {
+	row, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return SparseEntry{}, fmt.Errorf("invalid row %q", fields[0])
+	}
+	col, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return SparseEntry{}, fmt.Errorf("invalid column %q", fields[1])
+	}
+	value, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return SparseEntry{}, fmt.Errorf("invalid value %q", fields[2])
+	}
+	if row < 1 || row > size || col < 1 || col > size {
+		return SparseEntry{}, fmt.Errorf("index (%d, %d) is outside a %dx%d matrix", row, col, size, size)
+	}
+	return SparseEntry{Row: row - 1, Col: col - 1, Value: value}, nil
+}
@@ -0,0 +1,656 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The constructors below build expression nodes and apply simple algebraic
+// rules as they go (constant folding, identities such as 0 + a and 1 * a,
+// like terms and powers of the same base), so trees produced by Derivative
+// stay readable.
+
+func numberNode(v float64) *Expression {
+	return &Expression{Kind: ExprNumber, Value: v, Name: strconv.FormatFloat(v, 'g', -1, 64)}
+}
+
+func isNumber(e *Expression, v float64) bool {
+	return e.Kind == ExprNumber && e.Value == v
+}
+
+// Equal reports whether two expressions have the same tree.
+func (e *Expression) Equal(other *Expression) bool {
+	if e.Kind != other.Kind || e.Op != other.Op || len(e.Args) != len(other.Args) {
+		return false
+	}
+	switch e.Kind {
+	case ExprNumber:
+		return e.Value == other.Value
+	case ExprVariable, ExprCall:
+		if e.Name != other.Name {
+			return false
+		}
+	}
+	for i := range e.Args {
+		if !e.Args[i].Equal(other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// dependsOn reports whether the expression uses the variable.
+func (e *Expression) dependsOn(variable string) bool {
+	if e.Kind == ExprVariable {
+		return e.Name == variable
+	}
+	for _, arg := range e.Args {
+		if arg.dependsOn(variable) {
+			return true
+		}
+	}
+	return false
+}
+
+// Size is the number of nodes in the tree.
+func (e *Expression) Size() int {
+	n := 1
+	for _, arg := range e.Args {
+		n += arg.Size()
+	}
+	return n
+}
+
+func binaryNode(op string, a, b *Expression) *Expression {
+	return &Expression{Kind: ExprBinary, Op: op, Args: []*Expression{a, b}}
+}
+
+// callNode applies a function, evaluating log(e), log(1) and exp(0).
+func callNode(name string, a *Expression) *Expression {
+	switch {
+	case name == "ln":
+		return callNode("log", a)
+	case name == "log" && a.Kind == ExprVariable && a.Name == "e":
+		return numberNode(1)
+	case name == "log" && isNumber(a, 1):
+		return numberNode(0)
+	case name == "exp" && isNumber(a, 0):
+		return numberNode(1)
+	}
+	return &Expression{Kind: ExprCall, Name: name, Args: []*Expression{a}}
+}
+
+// term is coef/den * rest in a sum; rest is nil for the constant term.
+type term struct {
+	coef, den float64
+	rest      *Expression
+}
+
+// reduceRational writes num/den in lowest terms with a positive
+// denominator, or as a single number over 1 when either is not a small
+// integer.
+func reduceRational(num, den float64) (float64, float64) {
+	if den == 0 {
+		return num, den
+	}
+	if den < 0 {
+		num, den = -num, -den
+	}
+	if num == math.Trunc(num) && den == math.Trunc(den) && math.Abs(num) < 1<<53 && den < 1<<53 {
+		g := gcd(math.Abs(num), den)
+		return num / g, den / g
+	}
+	return num / den, 1
+}
+
+// collectTerms flattens a sum and merges like terms.
+func collectTerms(terms []term, e *Expression, sign float64) []term {
+	switch {
+	case e.Kind == ExprBinary && (e.Op == "+" || e.Op == "-"):
+		terms = collectTerms(terms, e.Args[0], sign)
+		if e.Op == "-" {
+			return collectTerms(terms, e.Args[1], -sign)
+		}
+		return collectTerms(terms, e.Args[1], sign)
+	case e.Kind == ExprUnary:
+		return collectTerms(terms, e.Args[0], -sign)
+	}
+	t := term{coef: sign, den: 1}
+	switch {
+	case e.Kind == ExprNumber:
+		t.coef *= e.Value
+	case e.Kind == ExprBinary && (e.Op == "*" || e.Op == "/"):
+		// Split off the rational factor so that 2*a + a/3 = 7*a/3 and
+		// 1/3 - 3 = -8/3.
+		p := newMonomial(e, false)
+		t.coef, t.den = reduceRational(t.coef*p.num, p.den)
+		p.num, p.den = 1, 1
+		if t.rest = p.build(); t.rest.Kind == ExprNumber {
+			t.coef *= t.rest.Value
+			t.rest = nil
+		}
+	default:
+		t.rest = e
+	}
+	for i := range terms {
+		if (terms[i].rest == nil && t.rest == nil) || (terms[i].rest != nil && t.rest != nil && terms[i].rest.Equal(t.rest)) {
+			terms[i].coef, terms[i].den = reduceRational(terms[i].coef*t.den+t.coef*terms[i].den, terms[i].den*t.den)
+			return terms
+		}
+	}
+	return append(terms, t)
+}
+
+// buildSum writes the terms back as a sum, starting with a positive term
+// when there is one and leaving the constant for last.
+func buildSum(terms []term) *Expression {
+	constant := term{den: 1}
+	var ordered []term
+	for _, t := range terms {
+		switch {
+		case t.rest == nil:
+			constant.coef, constant.den = reduceRational(constant.coef*t.den+t.coef*constant.den, constant.den*t.den)
+		case t.coef > 0 && len(ordered) > 0 && ordered[0].coef < 0:
+			ordered = append([]term{t}, ordered...)
+		case t.coef != 0:
+			ordered = append(ordered, t)
+		}
+	}
+	switch {
+	case constant.coef > 0 && len(ordered) > 0 && ordered[0].coef < 0:
+		// 1 - log(x) rather than -log(x) + 1.
+		ordered = append([]term{constant}, ordered...)
+	case constant.coef != 0:
+		ordered = append(ordered, constant)
+	}
+	var result *Expression
+	for _, t := range ordered {
+		p := &monomial{num: math.Abs(t.coef), den: t.den}
+		if t.rest != nil {
+			p.multiply(t.rest, false)
+		}
+		e := p.build()
+		switch {
+		case result == nil && t.coef < 0:
+			result = negNode(e)
+		case result == nil:
+			result = e
+		case t.coef < 0:
+			result = binaryNode("-", result, e)
+		default:
+			result = binaryNode("+", result, e)
+		}
+	}
+	if result == nil {
+		return numberNode(0)
+	}
+	return result
+}
+
+func addNode(a, b *Expression) *Expression {
+	return buildSum(collectTerms(collectTerms(nil, a, 1), b, 1))
+}
+
+func subNode(a, b *Expression) *Expression {
+	return buildSum(collectTerms(collectTerms(nil, a, 1), b, -1))
+}
+
+// factor is base^exponent in a product.
+type factor struct {
+	base, exponent *Expression
+}
+
+// monomial is num/den times the factors, with equal bases merged.
+type monomial struct {
+	num, den float64
+	factors  []factor
+}
+
+func newMonomial(e *Expression, inverse bool) *monomial {
+	p := &monomial{num: 1, den: 1}
+	p.multiply(e, inverse)
+	return p
+}
+
+func (p *monomial) multiply(e *Expression, inverse bool) {
+	switch {
+	case e.Kind == ExprNumber && inverse:
+		p.den *= e.Value
+		return
+	case e.Kind == ExprNumber:
+		p.num *= e.Value
+		return
+	case e.Kind == ExprUnary:
+		p.num = -p.num
+		p.multiply(e.Args[0], inverse)
+		return
+	case e.Kind == ExprBinary && e.Op == "*":
+		p.multiply(e.Args[0], inverse)
+		p.multiply(e.Args[1], inverse)
+		return
+	case e.Kind == ExprBinary && e.Op == "/":
+		p.multiply(e.Args[0], inverse)
+		p.multiply(e.Args[1], !inverse)
+		return
+	}
+	base, exponent := e, numberNode(1)
+	switch {
+	case e.Kind == ExprBinary && e.Op == "^":
+		base, exponent = e.Args[0], e.Args[1]
+	case e.Kind == ExprCall && e.Name == "sqrt":
+		base, exponent = e.Args[0], numberNode(0.5)
+	}
+	if inverse {
+		exponent = negNode(exponent)
+	}
+	for i := range p.factors {
+		if p.factors[i].base.Equal(base) {
+			p.factors[i].exponent = addNode(p.factors[i].exponent, exponent)
+			return
+		}
+	}
+	p.factors = append(p.factors, factor{base, exponent})
+}
+
+// isNegative reports whether the expression is a negative number, a
+// negation, or a product or quotient whose leading number is negative.
+func isNegative(e *Expression) bool {
+	for e.Kind == ExprBinary && (e.Op == "*" || e.Op == "/") {
+		e = e.Args[0]
+	}
+	return (e.Kind == ExprNumber && e.Value < 0) || e.Kind == ExprUnary
+}
+
+// factorRank orders factors as variables, then functions, then the rest.
+func factorRank(e *Expression) int {
+	switch e.Kind {
+	case ExprNumber:
+		return 0
+	case ExprVariable:
+		return 1
+	case ExprCall:
+		return 2
+	}
+	return 3
+}
+
+func gcd(a, b float64) float64 {
+	for b != 0 {
+		a, b = b, math.Mod(a, b)
+	}
+	return a
+}
+
+func powerFactor(base, exponent *Expression) *Expression {
+	switch {
+	case isNumber(exponent, 1):
+		return base
+	case isNumber(exponent, 0.5):
+		return &Expression{Kind: ExprCall, Name: "sqrt", Args: []*Expression{base}}
+	}
+	return binaryNode("^", base, exponent)
+}
+
+func (p *monomial) build() *Expression {
+	if p.num == 0 {
+		return numberNode(0)
+	}
+	num, den := reduceRational(p.num, p.den)
+	negative := num < 0
+	num = math.Abs(num)
+
+	sort.SliceStable(p.factors, func(i, j int) bool {
+		ri, rj := factorRank(p.factors[i].base), factorRank(p.factors[j].base)
+		if ri != rj {
+			return ri < rj
+		}
+		return p.factors[i].base.String() < p.factors[j].base.String()
+	})
+	var numerator, denominator *Expression
+	appendFactor := func(to *Expression, e *Expression) *Expression {
+		if to == nil {
+			return e
+		}
+		return binaryNode("*", to, e)
+	}
+	if num != 1 {
+		numerator = numberNode(num)
+	}
+	if den != 1 {
+		denominator = numberNode(den)
+	}
+	for _, f := range p.factors {
+		switch {
+		case isNumber(f.exponent, 0):
+		case isNegative(f.exponent):
+			denominator = appendFactor(denominator, powerFactor(f.base, negNode(f.exponent)))
+		default:
+			numerator = appendFactor(numerator, powerFactor(f.base, f.exponent))
+		}
+	}
+	// The sign goes on the leading number, or in front when there is none.
+	switch {
+	case numerator == nil && negative:
+		numerator = numberNode(-1)
+	case numerator == nil:
+		numerator = numberNode(1)
+	case negative && num != 1:
+		first := numerator
+		for first.Kind == ExprBinary {
+			first = first.Args[0]
+		}
+		first.Value, first.Name = -num, strconv.FormatFloat(-num, 'g', -1, 64)
+	case negative:
+		numerator = &Expression{Kind: ExprUnary, Op: "-", Args: []*Expression{numerator}}
+	}
+	if denominator == nil {
+		return numerator
+	}
+	return binaryNode("/", numerator, denominator)
+}
+
+func negNode(a *Expression) *Expression {
+	switch {
+	case a.Kind == ExprNumber:
+		return numberNode(-a.Value)
+	case a.Kind == ExprUnary:
+		return a.Args[0]
+	case a.Kind == ExprBinary && (a.Op == "+" || a.Op == "-"):
+		return buildSum(collectTerms(nil, a, -1))
+	case a.Kind == ExprBinary && (a.Op == "*" || a.Op == "/"):
+		p := newMonomial(a, false)
+		p.num = -p.num
+		return p.build()
+	}
+	return &Expression{Kind: ExprUnary, Op: "-", Args: []*Expression{a}}
+}
+
+func mulNode(a, b *Expression) *Expression {
+	p := newMonomial(a, false)
+	p.multiply(b, false)
+	return p.build()
+}
+
+func divNode(a, b *Expression) *Expression {
+	if isNumber(b, 0) {
+		return binaryNode("/", a, b)
+	}
+	p := newMonomial(a, false)
+	p.multiply(b, true)
+	return p.build()
+}
+
+func powNode(a, b *Expression) *Expression {
+	switch {
+	case isNumber(b, 0):
+		return numberNode(1)
+	case isNumber(b, 1):
+		return a
+	case isNumber(a, 1):
+		return numberNode(1)
+	case a.Kind == ExprNumber && b.Kind == ExprNumber && b.Value == math.Trunc(b.Value) && b.Value > 0 &&
+		a.Value == math.Trunc(a.Value) && math.Abs(math.Pow(a.Value, b.Value)) < 1<<53:
+		return numberNode(math.Pow(a.Value, b.Value))
+	case b.Kind == ExprNumber && b.Value == math.Trunc(b.Value) && a.Kind != ExprNumber &&
+		(a.Kind != ExprBinary || a.Op != "+" && a.Op != "-"):
+		// (2*x^3/y)^2 = 4*x^6/y^2 holds for integer exponents.
+		p := newMonomial(a, false)
+		n := math.Abs(b.Value)
+		result := &monomial{num: math.Pow(p.num, n), den: math.Pow(p.den, n)}
+		if b.Value < 0 {
+			result.num, result.den = result.den, result.num
+		}
+		for _, f := range p.factors {
+			result.factors = append(result.factors, factor{f.base, mulNode(f.exponent, b)})
+		}
+		return result.build()
+	}
+	return newMonomial(binaryNode("^", a, b), false).build()
+}
+
+// Simplify rebuilds the expression with the simplification rules used for
+// derivatives.
+func (e *Expression) Simplify() *Expression {
+	switch e.Kind {
+	case ExprUnary:
+		return negNode(e.Args[0].Simplify())
+	case ExprBinary:
+		a, b := e.Args[0].Simplify(), e.Args[1].Simplify()
+		switch e.Op {
+		case "+":
+			return addNode(a, b)
+		case "-":
+			return subNode(a, b)
+		case "*":
+			return mulNode(a, b)
+		case "/":
+			return divNode(a, b)
+		case "^":
+			return powNode(a, b)
+		}
+	case ExprCall:
+		args := make([]*Expression, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = arg.Simplify()
+		}
+		if e.Name == "log" && len(args) == 2 {
+			return divNode(callNode("log", args[0]), callNode("log", args[1]))
+		}
+		if e.Name == "ln" {
+			return callNode("log", args[0])
+		}
+		return callNode(e.Name, args[0])
+	}
+	return e
+}
+
+// derivativeOf returns f'(a) for the named function, without the chain rule
+// factor a'.
+func derivativeOf(name string, a *Expression) (*Expression, error) {
+	one := numberNode(1)
+	switch name {
+	case "sin":
+		return callNode("cos", a), nil
+	case "cos":
+		return negNode(callNode("sin", a)), nil
+	case "tan":
+		return powNode(callNode("sec", a), numberNode(2)), nil
+	case "sec":
+		return mulNode(callNode("sec", a), callNode("tan", a)), nil
+	case "csc":
+		return negNode(mulNode(callNode("csc", a), callNode("cot", a))), nil
+	case "cot":
+		return negNode(powNode(callNode("csc", a), numberNode(2))), nil
+	case "asin":
+		return divNode(one, callNode("sqrt", subNode(one, powNode(a, numberNode(2))))), nil
+	case "acos":
+		return negNode(divNode(one, callNode("sqrt", subNode(one, powNode(a, numberNode(2)))))), nil
+	case "atan":
+		return divNode(one, addNode(one, powNode(a, numberNode(2)))), nil
+	case "sinh":
+		return callNode("cosh", a), nil
+	case "cosh":
+		return callNode("sinh", a), nil
+	case "tanh":
+		return subNode(one, powNode(callNode("tanh", a), numberNode(2))), nil
+	case "exp":
+		return callNode("exp", a), nil
+	case "log", "ln":
+		return divNode(one, a), nil
+	case "log10":
+		return divNode(one, mulNode(a, callNode("log", numberNode(10)))), nil
+	case "log2":
+		return divNode(one, mulNode(a, callNode("log", numberNode(2)))), nil
+	case "sqrt":
+		return divNode(one, mulNode(numberNode(2), callNode("sqrt", a))), nil
+	case "cbrt":
+		return divNode(one, mulNode(numberNode(3), powNode(callNode("cbrt", a), numberNode(2)))), nil
+	case "abs":
+		return divNode(a, callNode("abs", a)), nil
+	}
+	return nil, fmt.Errorf("unknown function %q", name)
+}
+
+// Derivative returns the simplified symbolic derivative of the expression
+// with respect to variable.
+func (e *Expression) Derivative(variable string) (*Expression, error) {
+	if !e.dependsOn(variable) {
+		return numberNode(0), nil
+	}
+	switch e.Kind {
+	case ExprVariable:
+		return numberNode(1), nil
+	case ExprUnary:
+		d, err := e.Args[0].Derivative(variable)
+		if err != nil {
+			return nil, err
+		}
+		return negNode(d), nil
+	case ExprBinary:
+		a, b := e.Args[0].Simplify(), e.Args[1].Simplify()
+		da, err := a.Derivative(variable)
+		if err != nil {
+			return nil, err
+		}
+		db, err := b.Derivative(variable)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Op {
+		case "+":
+			return addNode(da, db), nil
+		case "-":
+			return subNode(da, db), nil
+		case "*":
+			return addNode(mulNode(da, b), mulNode(a, db)), nil
+		case "/":
+			if !b.dependsOn(variable) {
+				return divNode(da, b), nil
+			}
+			// d(a/b) = a'/b - a b'/b^2, which keeps the terms as monomials.
+			return subNode(divNode(da, b), divNode(mulNode(a, db), powNode(b, numberNode(2)))), nil
+		case "^":
+			if !b.dependsOn(variable) {
+				// d(a^c) = c a^(c-1) a'
+				return mulNode(mulNode(b, powNode(a, subNode(b, numberNode(1)))), da), nil
+			}
+			if !a.dependsOn(variable) {
+				// d(c^b) = c^b log(c) b'
+				return mulNode(mulNode(powNode(a, b), callNode("log", a)), db), nil
+			}
+			// d(a^b) = a^b (b' log(a) + b a' / a)
+			return mulNode(powNode(a, b), addNode(mulNode(db, callNode("log", a)), divNode(mulNode(b, da), a))), nil
+		}
+	case ExprCall:
+		if e.Name == "log" && len(e.Args) == 2 {
+			return e.Simplify().Derivative(variable)
+		}
+		a := e.Args[0].Simplify()
+		da, err := a.Derivative(variable)
+		if err != nil {
+			return nil, err
+		}
+		outer, err := derivativeOf(e.Name, a)
+		if err != nil {
+			return nil, err
+		}
+		return mulNode(outer, da), nil
+	}
+	return nil, fmt.Errorf("invalid expression")
+}
+
+// Operator precedence used by String.
+const (
+	precSum = iota + 1
+	precProduct
+	precUnary
+	precPower
+	precAtom
+)
+
+func (e *Expression) precedence() int {
+	switch e.Kind {
+	case ExprNumber:
+		if e.Value < 0 {
+			return precUnary
+		}
+	case ExprUnary:
+		return precUnary
+	case ExprBinary:
+		switch e.Op {
+		case "+", "-":
+			return precSum
+		case "*", "/":
+			return precProduct
+		case "^":
+			return precPower
+		}
+	}
+	return precAtom
+}
+
+// String formats the expression in the syntax accepted by ParseExpression
+// and by mathjs, with only the parentheses that are needed.
+func (e *Expression) String() string {
+	var sb strings.Builder
+	e.write(&sb)
+	return sb.String()
+}
+
+func (e *Expression) writeOperand(sb *strings.Builder, parenthesize bool) {
+	if parenthesize {
+		sb.WriteByte('(')
+		e.write(sb)
+		sb.WriteByte(')')
+		return
+	}
+	e.write(sb)
+}
+
+func (e *Expression) write(sb *strings.Builder) {
+	switch e.Kind {
+	case ExprNumber:
+		if e.Name != "" {
+			sb.WriteString(e.Name)
+		} else {
+			sb.WriteString(strconv.FormatFloat(e.Value, 'g', -1, 64))
+		}
+	case ExprVariable:
+		sb.WriteString(e.Name)
+	case ExprUnary:
+		sb.WriteByte('-')
+		e.Args[0].writeOperand(sb, e.Args[0].precedence() < precProduct)
+	case ExprBinary:
+		p := e.precedence()
+		a, b := e.Args[0], e.Args[1]
+		if e.Op == "^" {
+			a.writeOperand(sb, a.precedence() <= p)
+			sb.WriteByte('^')
+			b.writeOperand(sb, b.precedence() < p)
+			return
+		}
+		a.writeOperand(sb, a.precedence() < p)
+		if p == precSum {
+			sb.WriteString(" " + e.Op + " ")
+		} else {
+			sb.WriteString(e.Op)
+		}
+		// The right operand of - and / groups, and a leading minus always gets
+		// parentheses: a - (b + c), a/(b*c), a*(-b).
+		rightPrec := b.precedence()
+		b.writeOperand(sb, rightPrec < p || rightPrec == precUnary || (rightPrec == p && (e.Op == "-" || e.Op == "/" || e.Op == "*")))
+	case ExprCall:
+		sb.WriteString(e.Name)
+		sb.WriteByte('(')
+		for i, arg := range e.Args {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			arg.write(sb)
+		}
+		sb.WriteByte(')')
+	}
+}
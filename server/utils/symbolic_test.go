@@ -0,0 +1,82 @@
+package utils
+
+import "testing"
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"x + x", "2*x"},
+		{"2*x + 3*x", "5*x"},
+		{"x - x", "0"},
+		{"0 + x", "x"},
+		{"1*x", "x"},
+		{"y*x", "x*y"},
+		{"-(-x)", "x"},
+		{"x*x", "x^2"},
+		{"x^2*x^3", "x^5"},
+		{"x/x", "1"},
+		{"x^1", "x"},
+		{"x^0", "1"},
+		{"2*x/4", "x/2"},
+		{"(2*x^3/y)^2", "4*x^6/y^2"},
+		{"sqrt(x)*sqrt(x)", "x"},
+		{"log(e)", "1"},
+		{"exp(0)", "1"},
+		{"3 - log(x)", "3 - log(x)"},
+		{"0.5*x + 0.25*x", "0.75*x"},
+		// Rational constants are folded.
+		{"1/3 - 3", "-8/3"},
+		{"1/3 + 1/6", "1/2"},
+		{"x - 1/2 + 1/2", "x"},
+		{"x/3 + x/6", "x/2"},
+		{"2*x + x/3", "7*x/3"},
+		{"x^(1/2)*x^(1/2)", "x"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Errorf("ParseExpression(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.Simplify().String(); got != tt.want {
+			t.Errorf("Simplify(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	tests := []struct {
+		input string
+		order int
+		want  string
+	}{
+		{"x^3", 1, "3*x^2"},
+		{"x^2*y", 1, "2*x*y"},
+		{"1/x", 1, "-1/x^2"},
+		{"sqrt(x)", 1, "1/(2*sqrt(x))"},
+		{"tan(x)", 1, "sec(x)^2"},
+		{"x*exp(x)", 1, "exp(x) + x*exp(x)"},
+		{"x^x", 1, "x^x*(log(x) + 1)"},
+		{"sin(x)", 2, "-sin(x)"},
+		{"log(x)", 2, "-1/x^2"},
+		{"x^(1/3)", 3, "10/(27*x^(8/3))"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpression(tt.input)
+		if err != nil {
+			t.Errorf("ParseExpression(%q) error: %v", tt.input, err)
+			continue
+		}
+		for i := 0; i < tt.order && err == nil; i++ {
+			expr, err = expr.Derivative("x")
+		}
+		if err != nil {
+			t.Errorf("Derivative(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("order %d derivative of %q = %q, want %q", tt.order, tt.input, got, tt.want)
+		}
+	}
+}
@@ -7,16 +7,53 @@ import (
 
 type (
 	ReqTrapezoid struct {
-		Function string  `json:"function"`
-		Lower    float64 `json:"lower"`
-		Upper    float64 `json:"upper"`
-		Interval int     `json:"interval"`
+		Function  string  `json:"function"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		Interval  int     `json:"interval"`
+		Precision uint    `json:"precision"`
 	}
 	ReqSimpson struct {
+		Function  string  `json:"function"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		Interval  int     `json:"interval"`
+		Precision uint    `json:"precision"`
+	}
+
+	ReqMultipleIntegral struct {
+		Function  string  `json:"function"`
+		Method    string  `json:"method"`
+		XLower    float64 `json:"x_lower"`
+		XUpper    float64 `json:"x_upper"`
+		YLower    string  `json:"y_lower"`
+		YUpper    string  `json:"y_upper"`
+		ZLower    string  `json:"z_lower"`
+		ZUpper    string  `json:"z_upper"`
+		XInterval int     `json:"x_interval"`
+		YInterval int     `json:"y_interval"`
+		ZInterval int     `json:"z_interval"`
+	}
+
+	ReqImproperIntegral struct {
 		Function string  `json:"function"`
-		Lower    float64 `json:"lower"`
-		Upper    float64 `json:"upper"`
-		Interval int     `json:"interval"`
+		Lower    string  `json:"lower"`
+		Upper    string  `json:"upper"`
+		Method   string  `json:"method"`
+		E        float64 `json:"e"`
+	}
+
+	// ReqMonteCarlo takes Seed by pointer so that a missing seed can be told
+	// apart from 0.
+	ReqMonteCarlo struct {
+		Function   string `json:"function"`
+		Lower      string `json:"lower"`
+		Upper      string `json:"upper"`
+		Method     string `json:"method"`
+		Samples    int    `json:"samples"`
+		Antithetic bool   `json:"antithetic"`
+		Strata     int    `json:"strata"`
+		Seed       *int64 `json:"seed"`
 	}
 
 	IntegrationValidateImpl struct{}
@@ -25,6 +62,9 @@ type (
 type IntegrationValidate interface {
 	ValidateTrapezoid(c *fiber.Ctx) error
 	ValidateSimpson(c *fiber.Ctx) error
+	ValidateMultipleIntegral(c *fiber.Ctx) error
+	ValidateImproperIntegral(c *fiber.Ctx) error
+	ValidateMonteCarlo(c *fiber.Ctx) error
 }
 
 func NewIntegrationValidate() IntegrationValidate {
@@ -40,6 +80,11 @@ func (v *IntegrationValidateImpl) ValidateTrapezoid(c *fiber.Ctx) error {
 		})
 	}
 
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -52,6 +97,77 @@ func (v *IntegrationValidateImpl) ValidateSimpson(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *IntegrationValidateImpl) ValidateMultipleIntegral(c *fiber.Ctx) error {
+	var req ReqMultipleIntegral
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *IntegrationValidateImpl) ValidateImproperIntegral(c *fiber.Ctx) error {
+	var req ReqImproperIntegral
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	if req.Lower == "" || req.Upper == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "lower and upper are required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *IntegrationValidateImpl) ValidateMonteCarlo(c *fiber.Ctx) error {
+	var req ReqMonteCarlo
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	if req.Lower == "" || req.Upper == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "lower and upper are required",
+		})
+	}
+	if req.Seed == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "seed is required",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -31,6 +31,45 @@ type (
 		Point  string `json:"point"`
 		Xvalue string `json:"xvalue"`
 	}
+	ReqChebyshev struct {
+		Function  string  `json:"function"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		Kind      int     `json:"kind"`
+		Points    int     `json:"points"`
+		Tolerance float64 `json:"tolerance"`
+		Xvalue    float64 `json:"xvalue"`
+	}
+	ReqPade struct {
+		Function string  `json:"function"`
+		X0       float64 `json:"x0"`
+		M        int     `json:"m"`
+		N        int     `json:"n"`
+		Xvalue   float64 `json:"xvalue"`
+	}
+	ReqFloaterHormann struct {
+		Points string  `json:"points"`
+		D      int     `json:"d"`
+		Xvalue float64 `json:"xvalue"`
+	}
+	ReqBivariate struct {
+		Grid    string `json:"grid"`
+		Method  string `json:"method"`
+		Queries string `json:"queries"`
+	}
+	ReqHermite struct {
+		Points string  `json:"points"`
+		Method string  `json:"method"`
+		Xvalue float64 `json:"xvalue"`
+	}
+	ReqFFT struct {
+		Points   string  `json:"points"`
+		Function string  `json:"function"`
+		Lower    float64 `json:"lower"`
+		Upper    float64 `json:"upper"`
+		Samples  int     `json:"samples"`
+		Xvalues  string  `json:"xvalues"`
+	}
 
 	InterpolationValidateImpl struct{}
 )
@@ -41,6 +80,12 @@ type InterpolationValidate interface {
 	ValidatePolynomialNewton(c *fiber.Ctx) error
 	ValidateQuadraticLagrange(c *fiber.Ctx) error
 	ValidateQuadraticSpline(c *fiber.Ctx) error
+	ValidateChebyshev(c *fiber.Ctx) error
+	ValidatePade(c *fiber.Ctx) error
+	ValidateFloaterHormann(c *fiber.Ctx) error
+	ValidateBivariate(c *fiber.Ctx) error
+	ValidateHermite(c *fiber.Ctx) error
+	ValidateFFT(c *fiber.Ctx) error
 }
 
 func NewInterpolationValidate() InterpolationValidate {
@@ -106,3 +151,110 @@ func (v *InterpolationValidateImpl) ValidateQuadraticSpline(c *fiber.Ctx) error
 	c.Locals("req", req)
 	return c.Next()
 }
+
+func (v *InterpolationValidateImpl) ValidateChebyshev(c *fiber.Ctx) error {
+	var req ReqChebyshev
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *InterpolationValidateImpl) ValidatePade(c *fiber.Ctx) error {
+	var req ReqPade
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *InterpolationValidateImpl) ValidateFloaterHormann(c *fiber.Ctx) error {
+	var req ReqFloaterHormann
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *InterpolationValidateImpl) ValidateBivariate(c *fiber.Ctx) error {
+	var req ReqBivariate
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Grid == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "grid is required",
+		})
+	}
+	if req.Queries == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "queries is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *InterpolationValidateImpl) ValidateHermite(c *fiber.Ctx) error {
+	var req ReqHermite
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *InterpolationValidateImpl) ValidateFFT(c *fiber.Ctx) error {
+	var req ReqFFT
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" && req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points or function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
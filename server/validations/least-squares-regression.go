@@ -19,6 +19,26 @@ type (
 		Points string `json:"points"`
 		Xvalue string `json:"xvalue"`
 	}
+	ReqNonlinearRegression struct {
+		Points     string  `json:"points"`
+		Model      string  `json:"model"`
+		Parameters string  `json:"parameters"`
+		Initial    string  `json:"initial"`
+		E          float64 `json:"e"`
+		Xvalue     float64 `json:"xvalue"`
+	}
+	ReqBSplineRegression struct {
+		Points   string  `json:"points"`
+		Degree   int     `json:"degree"`
+		Knots    string  `json:"knots"`
+		Segments int     `json:"segments"`
+		Xvalue   float64 `json:"xvalue"`
+	}
+	ReqSmoothingSpline struct {
+		Points string  `json:"points"`
+		Lambda float64 `json:"lambda"`
+		Xvalue float64 `json:"xvalue"`
+	}
 
 	LeastSquaresRegressionValidateImpl struct{}
 )
@@ -27,6 +47,9 @@ type LeastSquaresRegressionValidate interface {
 	ValidateLinearRegression(c *fiber.Ctx) error
 	ValidatePolynomialRegression(c *fiber.Ctx) error
 	ValidateMultipleRegression(c *fiber.Ctx) error
+	ValidateNonlinearRegression(c *fiber.Ctx) error
+	ValidateBSplineRegression(c *fiber.Ctx) error
+	ValidateSmoothingSpline(c *fiber.Ctx) error
 }
 
 func NewLeastSquaresRegressionValidate() LeastSquaresRegressionValidate {
@@ -68,3 +91,59 @@ func (v *LeastSquaresRegressionValidateImpl) ValidateMultipleRegression(c *fiber
 	c.Locals("req", req)
 	return c.Next()
 }
+
+func (v *LeastSquaresRegressionValidateImpl) ValidateNonlinearRegression(c *fiber.Ctx) error {
+	var req ReqNonlinearRegression
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points is required",
+		})
+	}
+	if req.Model == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "model is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LeastSquaresRegressionValidateImpl) ValidateBSplineRegression(c *fiber.Ctx) error {
+	var req ReqBSplineRegression
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LeastSquaresRegressionValidateImpl) ValidateSmoothingSpline(c *fiber.Ctx) error {
+	var req ReqSmoothingSpline
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Points == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "points is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
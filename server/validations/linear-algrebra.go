@@ -1,6 +1,9 @@
 package validations
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/BaimhonS/numerical-method/utils"
 	"github.com/gofiber/fiber/v2"
 )
@@ -10,6 +13,7 @@ type (
 		MatrixSize   int    `json:"matrix_size"`
 		MatrixData   string `json:"matrix_data"`
 		ConstantData string `json:"constant_data"`
+		Precision    uint   `json:"precision"`
 	}
 
 	ReqMatrixIteration struct {
@@ -18,12 +22,49 @@ type (
 		Error        float64 `json:"error"`
 		ConstantData string  `json:"constant_data"`
 	}
+
+	ReqGMRES struct {
+		MatrixSize    int     `json:"matrix_size"`
+		MatrixEntries string  `json:"matrix_entries"`
+		ConstantData  string  `json:"constant_data"`
+		E             float64 `json:"e"`
+		Restart       int     `json:"restart"`
+	}
+
+	ReqBiCGSTAB struct {
+		MatrixSize    int     `json:"matrix_size"`
+		MatrixEntries string  `json:"matrix_entries"`
+		ConstantData  string  `json:"constant_data"`
+		E             float64 `json:"e"`
+	}
+
+	ReqPCG struct {
+		Preconditioner string  `query:"preconditioner"`
+		Omega          float64 `query:"omega"`
+	}
+
+	ReqSOR struct {
+		Method string  `query:"method"`
+		Omega  float64 `query:"omega"`
+		Auto   bool    `query:"auto"`
+	}
+
+	ReqQR struct {
+		Method string `query:"method"`
+	}
 	LinearValidateImpl struct{}
 )
 
 type LinearValidate interface {
 	ValidateMatrix(c *fiber.Ctx) error
 	ValidateMatrixIteration(c *fiber.Ctx) error
+	ValidateGMRES(c *fiber.Ctx) error
+	ValidateGMRESUpload(c *fiber.Ctx) error
+	ValidateBiCGSTAB(c *fiber.Ctx) error
+	ValidateBiCGSTABUpload(c *fiber.Ctx) error
+	ValidatePCG(c *fiber.Ctx) error
+	ValidateSOR(c *fiber.Ctx) error
+	ValidateQR(c *fiber.Ctx) error
 }
 
 func NewLinearValidate() LinearValidate {
@@ -37,6 +78,11 @@ func (v *LinearValidateImpl) ValidateMatrix(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -52,3 +98,158 @@ func (v *LinearValidateImpl) ValidateMatrixIteration(c *fiber.Ctx) error {
 	c.Locals("req", req)
 	return c.Next()
 }
+
+func (v *LinearValidateImpl) ValidateGMRES(c *fiber.Ctx) error {
+	var req ReqGMRES
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Restart < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "restart must not be negative",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LinearValidateImpl) ValidateGMRESUpload(c *fiber.Ctx) error {
+	size, entries, e, err := parseMatrixMarketForm(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	restart := 0
+	if value := c.FormValue("restart"); value != "" {
+		if restart, err = strconv.Atoi(value); err != nil || restart < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+				Message: "restart must be a non-negative integer",
+			})
+		}
+	}
+	c.Locals("req", ReqGMRES{
+		MatrixSize:    size,
+		MatrixEntries: entries,
+		ConstantData:  c.FormValue("constant_data"),
+		E:             e,
+		Restart:       restart,
+	})
+	return c.Next()
+}
+
+func (v *LinearValidateImpl) ValidateBiCGSTAB(c *fiber.Ctx) error {
+	var req ReqBiCGSTAB
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LinearValidateImpl) ValidateBiCGSTABUpload(c *fiber.Ctx) error {
+	size, entries, e, err := parseMatrixMarketForm(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+	c.Locals("req", ReqBiCGSTAB{
+		MatrixSize:    size,
+		MatrixEntries: entries,
+		ConstantData:  c.FormValue("constant_data"),
+		E:             e,
+	})
+	return c.Next()
+}
+
+// parseMatrixMarketForm reads the "matrix" Matrix Market file and the "e"
+// tolerance from a multipart upload and converts the file to COO triplets.
+func parseMatrixMarketForm(c *fiber.Ctx) (int, string, float64, error) {
+	header, err := c.FormFile("matrix")
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("matrix file is required")
+	}
+	file, err := header.Open()
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("failed to open matrix file")
+	}
+	defer file.Close()
+
+	size, entries, err := utils.ParseMatrixMarket(file)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("invalid Matrix Market file: %v", err)
+	}
+	e, err := strconv.ParseFloat(c.FormValue("e"), 64)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("e must be a number")
+	}
+	return size, utils.FormatSparseEntries(entries), e, nil
+}
+
+func (v *LinearValidateImpl) ValidatePCG(c *fiber.Ctx) error {
+	var req ReqPCG
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "query parser error",
+			Error:   err,
+		})
+	}
+	if req.Preconditioner != "" && req.Preconditioner != "none" && req.Preconditioner != "jacobi" && req.Preconditioner != "ssor" && req.Preconditioner != "ic0" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "preconditioner must be none, jacobi, ssor or ic0",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LinearValidateImpl) ValidateSOR(c *fiber.Ctx) error {
+	var req ReqSOR
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "query parser error",
+			Error:   err,
+		})
+	}
+	if req.Method != "" && req.Method != "sor" && req.Method != "ssor" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "method must be sor or ssor",
+		})
+	}
+	if req.Auto && req.Method == "ssor" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "auto omega is only available for sor",
+		})
+	}
+	if !req.Auto && (req.Omega <= 0 || req.Omega >= 2) {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "omega must be between 0 and 2, or set auto",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *LinearValidateImpl) ValidateQR(c *fiber.Ctx) error {
+	var req ReqQR
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "query parser error",
+			Error:   err,
+		})
+	}
+	if req.Method != "" && req.Method != "cgs" && req.Method != "mgs" && req.Method != "householder" && req.Method != "givens" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "method must be cgs, mgs, householder or givens",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
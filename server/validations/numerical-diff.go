@@ -13,11 +13,18 @@ type (
 		Order    int     `json:"order"`
 	}
 
+	ReqSymbolicDiff struct {
+		Function string  `json:"function"`
+		X        float64 `json:"x"`
+		Order    int     `json:"order"`
+	}
+
 	NumericalDiffValidateImpl struct{}
 )
 
 type NumericalDiffValidate interface {
 	ValidateNumericalDiff(c *fiber.Ctx) error
+	ValidateSymbolicDiff(c *fiber.Ctx) error
 }
 
 func NewNumericalDiffValidate() NumericalDiffValidate {
@@ -35,3 +42,25 @@ func (v *NumericalDiffValidateImpl) ValidateNumericalDiff(c *fiber.Ctx) error {
 	c.Locals("req", req)
 	return c.Next()
 }
+
+func (v *NumericalDiffValidateImpl) ValidateSymbolicDiff(c *fiber.Ctx) error {
+	var req ReqSymbolicDiff
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	if req.Order < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "order must be at least 1",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
@@ -0,0 +1,49 @@
+package validations
+
+import (
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	ReqBVP struct {
+		Function  string  `json:"function"`
+		Lower     float64 `json:"lower"`
+		Upper     float64 `json:"upper"`
+		Alpha     float64 `json:"alpha"`
+		Beta      float64 `json:"beta"`
+		Intervals int     `json:"intervals"`
+		Method    string  `json:"method"`
+		Slope0    float64 `json:"slope0"`
+		Slope1    float64 `json:"slope1"`
+		E         float64 `json:"e"`
+		Exact     string  `json:"exact"`
+	}
+
+	ODEValidateImpl struct{}
+)
+
+type ODEValidate interface {
+	ValidateBVP(c *fiber.Ctx) error
+}
+
+func NewODEValidate() ODEValidate {
+	return &ODEValidateImpl{}
+}
+
+func (v *ODEValidateImpl) ValidateBVP(c *fiber.Ctx) error {
+	var req ReqBVP
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
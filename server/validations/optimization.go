@@ -0,0 +1,150 @@
+package validations
+
+import (
+	"strings"
+
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	ReqGoldenSection struct {
+		Equation string  `json:"equation"`
+		Xl       float64 `json:"xl"`
+		Xr       float64 `json:"xr"`
+		E        float64 `json:"e"`
+		Goal     string  `json:"goal"`
+	}
+
+	ReqParabolicInterpolation struct {
+		Equation string  `json:"equation"`
+		Xl       float64 `json:"xl"`
+		Xr       float64 `json:"xr"`
+		E        float64 `json:"e"`
+		Goal     string  `json:"goal"`
+	}
+
+	ReqGradientDescent struct {
+		Equation  string  `json:"equation"`
+		Variables string  `json:"variables"`
+		X0        string  `json:"x0"`
+		Step      float64 `json:"step"`
+		E         float64 `json:"e"`
+		Goal      string  `json:"goal"`
+	}
+
+	ReqNelderMead struct {
+		Equation  string  `json:"equation"`
+		Variables string  `json:"variables"`
+		X0        string  `json:"x0"`
+		E         float64 `json:"e"`
+		Goal      string  `json:"goal"`
+	}
+
+	ReqBFGS struct {
+		Equation  string  `json:"equation"`
+		Variables string  `json:"variables"`
+		X0        string  `json:"x0"`
+		E         float64 `json:"e"`
+		Goal      string  `json:"goal"`
+	}
+
+	OptimizationValidateImpl struct{}
+)
+
+type OptimizationValidate interface {
+	ValidateGoldenSection(c *fiber.Ctx) error
+	ValidateParabolicInterpolation(c *fiber.Ctx) error
+	ValidateGradientDescent(c *fiber.Ctx) error
+	ValidateNelderMead(c *fiber.Ctx) error
+	ValidateBFGS(c *fiber.Ctx) error
+}
+
+func NewOptimizationValidate() OptimizationValidate {
+	return &OptimizationValidateImpl{}
+}
+
+func (v *OptimizationValidateImpl) ValidateGoldenSection(c *fiber.Ctx) error {
+	var req ReqGoldenSection
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if goal := strings.ToLower(req.Goal); goal != "" && goal != "min" && goal != "max" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "goal must be min or max",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *OptimizationValidateImpl) ValidateParabolicInterpolation(c *fiber.Ctx) error {
+	var req ReqParabolicInterpolation
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if goal := strings.ToLower(req.Goal); goal != "" && goal != "min" && goal != "max" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "goal must be min or max",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *OptimizationValidateImpl) ValidateGradientDescent(c *fiber.Ctx) error {
+	var req ReqGradientDescent
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if goal := strings.ToLower(req.Goal); goal != "" && goal != "min" && goal != "max" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "goal must be min or max",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *OptimizationValidateImpl) ValidateNelderMead(c *fiber.Ctx) error {
+	var req ReqNelderMead
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if goal := strings.ToLower(req.Goal); goal != "" && goal != "min" && goal != "max" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "goal must be min or max",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *OptimizationValidateImpl) ValidateBFGS(c *fiber.Ctx) error {
+	var req ReqBFGS
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if goal := strings.ToLower(req.Goal); goal != "" && goal != "min" && goal != "max" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "goal must be min or max",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
@@ -0,0 +1,125 @@
+package validations
+
+import (
+	"github.com/BaimhonS/numerical-method/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	ReqHeatEquation struct {
+		Initial   string  `json:"initial"`
+		Alpha     float64 `json:"alpha"`
+		XLower    float64 `json:"x_lower"`
+		XUpper    float64 `json:"x_upper"`
+		Left      string  `json:"left"`
+		Right     string  `json:"right"`
+		Intervals int     `json:"intervals"`
+		DT        float64 `json:"dt"`
+		Times     string  `json:"times"`
+		Method    string  `json:"method"`
+	}
+
+	ReqWaveEquation struct {
+		Initial   string  `json:"initial"`
+		Velocity  string  `json:"velocity"`
+		C         float64 `json:"c"`
+		XLower    float64 `json:"x_lower"`
+		XUpper    float64 `json:"x_upper"`
+		Left      string  `json:"left"`
+		Right     string  `json:"right"`
+		Intervals int     `json:"intervals"`
+		DT        float64 `json:"dt"`
+		Times     string  `json:"times"`
+	}
+
+	ReqPoisson struct {
+		Function   string  `json:"function"`
+		XLower     float64 `json:"x_lower"`
+		XUpper     float64 `json:"x_upper"`
+		YLower     float64 `json:"y_lower"`
+		YUpper     float64 `json:"y_upper"`
+		XIntervals int     `json:"x_intervals"`
+		YIntervals int     `json:"y_intervals"`
+		Left       string  `json:"left"`
+		Right      string  `json:"right"`
+		Bottom     string  `json:"bottom"`
+		Top        string  `json:"top"`
+		Neumann    string  `json:"neumann"`
+		Method     string  `json:"method"`
+		Omega      float64 `json:"omega"`
+		E          float64 `json:"e"`
+	}
+
+	PDEValidateImpl struct{}
+)
+
+type PDEValidate interface {
+	ValidateHeatEquation(c *fiber.Ctx) error
+	ValidateWaveEquation(c *fiber.Ctx) error
+	ValidatePoisson(c *fiber.Ctx) error
+}
+
+func NewPDEValidate() PDEValidate {
+	return &PDEValidateImpl{}
+}
+
+func (v *PDEValidateImpl) ValidateHeatEquation(c *fiber.Ctx) error {
+	var req ReqHeatEquation
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Initial == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "initial is required",
+		})
+	}
+	if req.Times == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "times is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *PDEValidateImpl) ValidateWaveEquation(c *fiber.Ctx) error {
+	var req ReqWaveEquation
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Initial == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "initial is required",
+		})
+	}
+	if req.Times == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "times is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *PDEValidateImpl) ValidatePoisson(c *fiber.Ctx) error {
+	var req ReqPoisson
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Function == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "function is required",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
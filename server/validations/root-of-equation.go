@@ -12,35 +12,46 @@ type (
 	}
 
 	ReqBisection struct {
-		Equation string  `json:"equation"`
-		Xl       float64 `json:"xl"`
-		Xr       float64 `json:"xr"`
-		E        float64 `json:"e"`
+		Equation  string  `json:"equation"`
+		Xl        float64 `json:"xl"`
+		Xr        float64 `json:"xr"`
+		E         float64 `json:"e"`
+		Precision uint    `json:"precision"`
 	}
 
 	ReqFalsePosition struct {
-		Equation string  `json:"equation"`
-		Xl       float64 `json:"xl"`
-		Xr       float64 `json:"xr"`
-		E        float64 `json:"e"`
+		Equation  string  `json:"equation"`
+		Xl        float64 `json:"xl"`
+		Xr        float64 `json:"xr"`
+		E         float64 `json:"e"`
+		Precision uint    `json:"precision"`
 	}
 
 	ReqOnePoint struct {
-		Equation string  `json:"equation"`
-		E        float64 `json:"e"`
+		Equation  string  `json:"equation"`
+		E         float64 `json:"e"`
+		Precision uint    `json:"precision"`
 	}
 
 	ReqNewtonRaphson struct {
-		Equation string  `json:"equation"`
-		X0       float64 `json:"x0"`
-		E        float64 `json:"e"`
+		Equation  string  `json:"equation"`
+		X0        float64 `json:"x0"`
+		E         float64 `json:"e"`
+		Precision uint    `json:"precision"`
 	}
 
 	ReqSecant struct {
-		Equation string  `json:"equation"`
-		X0       float64 `json:"x0"`
-		X1       float64 `json:"x1"`
-		E        float64 `json:"e"`
+		Equation  string  `json:"equation"`
+		X0        float64 `json:"x0"`
+		X1        float64 `json:"x1"`
+		E         float64 `json:"e"`
+		Precision uint    `json:"precision"`
+	}
+
+	ReqPolynomialRoots struct {
+		Equation     string  `json:"equation"`
+		Coefficients string  `json:"coefficients"`
+		E            float64 `json:"e"`
 	}
 
 	RootValidateImpl struct{}
@@ -53,6 +64,7 @@ type RootValidate interface {
 	ValidateOnePoint(c *fiber.Ctx) error
 	ValidateNewtonRaphson(c *fiber.Ctx) error
 	ValidateSecant(c *fiber.Ctx) error
+	ValidatePolynomialRoots(c *fiber.Ctx) error
 }
 
 func NewRootValidate() RootValidate {
@@ -79,6 +91,11 @@ func (v *RootValidateImpl) ValidateBisection(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -91,6 +108,11 @@ func (v *RootValidateImpl) ValidateFalsePosition(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -103,6 +125,11 @@ func (v *RootValidateImpl) ValidateOnePoint(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -115,6 +142,11 @@ func (v *RootValidateImpl) ValidateNewtonRaphson(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }
@@ -127,6 +159,28 @@ func (v *RootValidateImpl) ValidateSecant(c *fiber.Ctx) error {
 			Error:   err,
 		})
 	}
+	if req.Precision > 4096 {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "precision must be at most 4096 bits",
+		})
+	}
+	c.Locals("req", req)
+	return c.Next()
+}
+
+func (v *RootValidateImpl) ValidatePolynomialRoots(c *fiber.Ctx) error {
+	var req ReqPolynomialRoots
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "body parser error",
+			Error:   err,
+		})
+	}
+	if req.Equation == "" && req.Coefficients == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(utils.ErrorResponse{
+			Message: "equation or coefficients is required",
+		})
+	}
 	c.Locals("req", req)
 	return c.Next()
 }